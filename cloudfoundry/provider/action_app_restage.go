@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &appRestageAction{}
+	_ action.ActionWithConfigure = &appRestageAction{}
+)
+
+// Instantiates an app restage action.
+func NewAppRestageAction() action.Action {
+	return &appRestageAction{}
+}
+
+// Contains reference to the app resource whose staging and restart logic is reused.
+type appRestageAction struct {
+	app *appResource
+}
+
+func (a *appRestageAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_restage"
+}
+
+func (a *appRestageAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Stages the most recent package of an application again and restarts it with the new droplet, e.g. to pick up a buildpack or stack update without pushing new bits.
+
+__Note:__ Actions are supported from Terraform version 1.14 onwards and can be triggered from lifecycle hooks or with ` + "`terraform apply -invoke`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the application to restage.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+		},
+	}
+	for name, attribute := range appActionRolloutAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (a *appRestageAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.app = &appResource{cfClient: session.CFClient}
+}
+
+func (a *appRestageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data appRestartActionType
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := a.app.cfClient.Applications.Get(ctx, data.App.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching App",
+			"Could not get app with ID "+data.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Staging app %s", app.Name)})
+	curTime := time.Now()
+	dropletGUID, err := a.app.restageDroplet(ctx, app)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Restaging App",
+			"Could not stage app "+app.Name+" : "+err.Error()+appActionLogTrace(ctx, a.app, app, curTime),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting app %s with droplet %s", app.Name, dropletGUID)})
+	if err = a.app.restartApp(ctx, app, dropletGUID, data.mapRolloutOptionsToAppType()); err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Restaging App",
+			"Could not restart app "+app.Name+" : "+err.Error()+appActionLogTrace(ctx, a.app, app, curTime),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("App %s restaged", app.Name)})
+
+	tflog.Trace(ctx, "invoked an app restage action", map[string]any{"app": app.GUID, "droplet": dropletGUID})
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAppRestageAction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - restage app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restage")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_restage", `app = "`+testActionAppGUID+`"`),
+					Check:  resource.TestCheckResourceAttr("terraform_data.trigger", "input", "v1"),
+				},
+			},
+		})
+	})
+	t.Run("happy path - restage app with rolling strategy", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restage_rolling")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_restage", `
+						app                                 = "`+testActionAppGUID+`"
+						strategy                            = "rolling"
+						app_deployed_running_check_interval = 1`),
+					Check: resource.TestCheckResourceAttr("terraform_data.trigger", "input", "v1"),
+				},
+			},
+		})
+	})
+	t.Run("error path - app without package", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restage_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      hclProvider(nil) + hclAppAction("cloudfoundry_app_restage", `app = "9c2d4e6f-1a3b-4c5d-8e7f-6a5b4c3d2e1f"`),
+					ExpectError: regexp.MustCompile(`API Error Restaging App`),
+				},
+			},
+		})
+	})
+}
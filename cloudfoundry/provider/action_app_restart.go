@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &appRestartAction{}
+	_ action.ActionWithConfigure = &appRestartAction{}
+)
+
+// Instantiates an app restart action.
+func NewAppRestartAction() action.Action {
+	return &appRestartAction{}
+}
+
+// Contains reference to the app resource whose restart logic is reused.
+type appRestartAction struct {
+	app *appResource
+}
+
+func (a *appRestartAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_restart"
+}
+
+func (a *appRestartAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Restarts an application, e.g. to pick up rotated service credential bindings or changed environment variables. Stopped applications are started.
+
+__Note:__ Actions are supported from Terraform version 1.14 onwards and can be triggered from lifecycle hooks or with ` + "`terraform apply -invoke`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the application to restart.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+		},
+	}
+	for name, attribute := range appActionRolloutAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// Attributes shared by the app actions which restart the instances of an app.
+func appActionRolloutAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"strategy": schema.StringAttribute{
+			MarkdownDescription: "The strategy to restart the application with. Valid values are 'none' and 'rolling', defaults to 'none' which stops the application before starting it again.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "rolling"),
+			},
+		},
+		"max_in_flight": schema.Int64Attribute{
+			MarkdownDescription: "The maximum number of new instances to start simultaneously with 'rolling' strategy. Defaults to 1 on Cloud Foundry.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"app_deployed_running_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Timeout in minutes to wait for the instances of the application to be running. The default is 5 minutes. Min value is 1 minute.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(AppDeployedRunningTimeoutMinutesMinimum),
+			},
+		},
+		"app_deployed_running_check_interval": schema.Int64Attribute{
+			MarkdownDescription: "The interval in seconds between checks to see if the instances of the application are running. The default is 5 seconds. Min value is 1 second, max value is 30 seconds.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(AppDeployedRunningCheckIntervalSecondsMinimum),
+				int64validator.AtMost(AppDeployedRunningCheckIntervalSecondsMaximum),
+			},
+		},
+	}
+}
+
+func (a *appRestartAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.app = &appResource{cfClient: session.CFClient}
+}
+
+func (a *appRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data appRestartActionType
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := a.app.cfClient.Applications.Get(ctx, data.App.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching App",
+			"Could not get app with ID "+data.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting app %s", app.Name)})
+	curTime := time.Now()
+	if err = a.app.restartApp(ctx, app, "", data.mapRolloutOptionsToAppType()); err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Restarting App",
+			"Could not restart app "+app.Name+" : "+err.Error()+appActionLogTrace(ctx, a.app, app, curTime),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("App %s restarted", app.Name)})
+
+	tflog.Trace(ctx, "invoked an app restart action", map[string]any{"app": app.GUID})
+}
+
+// Returns the error logs of the app since the given time if log tracing is enabled, prefixed with a newline.
+func appActionLogTrace(ctx context.Context, r *appResource, app *cfv3resource.App, curTime time.Time) string {
+	if !isAppLogTraceEnabled() {
+		return ""
+	}
+	return "\n" + strings.Join(getAppLogTraceForApp(ctx, r, app, curTime), "")
+}
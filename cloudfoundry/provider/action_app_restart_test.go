@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// App with a package and a staged droplet which is restarted, restaged and scaled by the action tests.
+var testActionAppGUID = "4f1e7b2c-8d3a-4c5e-9b6f-0a1d2e3f4a5b"
+
+// Renders an action together with a terraform_data resource which triggers it after its creation.
+func hclAppAction(actionType string, actionConfig string) string {
+	return fmt.Sprintf(`
+		action "%[1]s" "action" {
+			config {
+				%[2]s
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = "v1"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.%[1]s.action]
+				}
+			}
+		}`, actionType, actionConfig)
+}
+
+func TestAppRestartAction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - restart app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restart")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_restart", `app = "`+testActionAppGUID+`"`),
+					Check:  resource.TestCheckResourceAttr("terraform_data.trigger", "input", "v1"),
+				},
+			},
+		})
+	})
+	t.Run("happy path - restart app with rolling strategy", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restart_rolling")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_restart", `
+						app                                 = "`+testActionAppGUID+`"
+						strategy                            = "rolling"
+						max_in_flight                       = 2
+						app_deployed_running_check_interval = 1`),
+					Check: resource.TestCheckResourceAttr("terraform_data.trigger", "input", "v1"),
+				},
+			},
+		})
+	})
+	t.Run("error path - app does not exist", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restart_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      hclProvider(nil) + hclAppAction("cloudfoundry_app_restart", `app = "`+invalidOrgGUID+`"`),
+					ExpectError: regexp.MustCompile(`API Error Fetching App`),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid strategy", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_restart_invalid_strategy")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_restart", `
+						app      = "`+testActionAppGUID+`"
+						strategy = "blue-green"`),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				},
+			},
+		})
+	})
+}
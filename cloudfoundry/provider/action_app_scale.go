@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action                     = &appScaleAction{}
+	_ action.ActionWithConfigure        = &appScaleAction{}
+	_ action.ActionWithConfigValidators = &appScaleAction{}
+)
+
+// Instantiates an app scale action.
+func NewAppScaleAction() action.Action {
+	return &appScaleAction{}
+}
+
+// Contains reference to the app resource whose restart logic is reused.
+type appScaleAction struct {
+	app *appResource
+}
+
+func (a *appScaleAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_scale"
+}
+
+func (a *appScaleAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Scales a process of an application, e.g. to temporarily add instances. Changes of the number of instances take effect immediately, changes of memory, disk quota or log rate limit restart started applications.
+
+__Note:__ Actions are supported from Terraform version 1.14 onwards and can be triggered from lifecycle hooks or with ` + "`terraform apply -invoke`" + `. The application resource managing the app reverts the scaling on its next apply unless its configuration is adapted.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the application to scale.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"process_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the process to scale, defaults to 'web'.",
+				Optional:            true,
+			},
+			"instances": schema.Int64Attribute{
+				MarkdownDescription: "The number of instances of the process.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"memory": schema.StringAttribute{
+				MarkdownDescription: "The memory limit for each instance of the process, e.g. '1G' or '512M'.",
+				Optional:            true,
+			},
+			"disk_quota": schema.StringAttribute{
+				MarkdownDescription: "The disk limit for each instance of the process, e.g. '1G' or '512M'.",
+				Optional:            true,
+			},
+			"log_rate_limit_per_second": schema.StringAttribute{
+				MarkdownDescription: "The log rate limit for each instance of the process, e.g. '1K', -1 stands for unlimited.",
+				Optional:            true,
+			},
+		},
+	}
+	for name, attribute := range appActionRolloutAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (a *appScaleAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("instances"),
+			path.MatchRoot("memory"),
+			path.MatchRoot("disk_quota"),
+			path.MatchRoot("log_rate_limit_per_second"),
+		),
+	}
+}
+
+func (a *appScaleAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.app = &appResource{cfClient: session.CFClient}
+}
+
+func (a *appScaleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data appScaleActionType
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scale, err := data.mapScaleValuesToProcessScale()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Scaling Values",
+			"Could not convert the scaling values : "+err.Error(),
+		)
+		return
+	}
+
+	app, err := a.app.cfClient.Applications.Get(ctx, data.App.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching App",
+			"Could not get app with ID "+data.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+	processType := "web"
+	if !data.ProcessType.IsNull() {
+		processType = data.ProcessType.ValueString()
+	}
+	opts := cfv3client.NewProcessOptions()
+	opts.Types = cfv3client.Filter{Values: []string{processType}}
+	process, err := a.app.cfClient.Processes.SingleForApp(ctx, app.GUID, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching Process",
+			"Could not get process "+processType+" of app "+app.Name+" : "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Scaling process %s of app %s", processType, app.Name)})
+	curTime := time.Now()
+	if _, err = a.app.cfClient.Processes.Scale(ctx, process.GUID, scale); err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Scaling App",
+			"Could not scale process "+processType+" of app "+app.Name+" : "+err.Error(),
+		)
+		return
+	}
+
+	if app.State == "STARTED" {
+		if scale.MemoryInMB != nil || scale.DiskInMB != nil || scale.LogRateLimitInBytesPerSecond != nil {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting app %s to apply the new limits", app.Name)})
+			err = a.app.restartApp(ctx, app, "", data.mapRolloutOptionsToAppType())
+		} else {
+			err = a.app.waitForAppInstancesRunning(ctx, app, data.mapRolloutOptionsToAppType())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Scaling App",
+				"Could not start the scaled instances of app "+app.Name+" : "+err.Error()+appActionLogTrace(ctx, a.app, app, curTime),
+			)
+			return
+		}
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Process %s of app %s scaled", processType, app.Name)})
+
+	tflog.Trace(ctx, "invoked an app scale action", map[string]any{"app": app.GUID, "process": process.GUID})
+}
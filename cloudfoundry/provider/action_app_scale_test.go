@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAppScaleAction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - scale instances and memory of app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_scale")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_scale", `
+						app       = "`+testActionAppGUID+`"
+						instances = 3
+						memory    = "512M"`),
+					Check: resource.TestCheckResourceAttr("terraform_data.trigger", "input", "v1"),
+				},
+			},
+		})
+	})
+	t.Run("error path - scaling values", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/action_app_scale_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      hclProvider(nil) + hclAppAction("cloudfoundry_app_scale", `app = "`+testActionAppGUID+`"`),
+					ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+				},
+				{
+					Config: hclProvider(nil) + hclAppAction("cloudfoundry_app_scale", `
+						app    = "`+testActionAppGUID+`"
+						memory = "16G"`),
+					ExpectError: regexp.MustCompile(`API Error Scaling App`),
+				},
+			},
+		})
+	})
+}
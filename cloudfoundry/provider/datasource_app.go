@@ -24,6 +24,7 @@ func NewAppDataSource() datasource.DataSource {
 
 type appDataSource struct {
 	cfClient *cfv3client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *appDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,6 +61,10 @@ func (d *appDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"lifecycle_type": schema.StringAttribute{
+				MarkdownDescription: "The lifecycle used to stage the application, one of buildpack, cnb or docker.",
+				Computed:            true,
+			},
 			"docker_image": schema.StringAttribute{
 				MarkdownDescription: "The URL to the docker image with tag",
 				Computed:            true,
@@ -245,6 +250,7 @@ func (d *appDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *appDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -254,23 +260,12 @@ func (d *appDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	org, err := d.cfClient.Organizations.Single(ctx, &cfv3client.OrganizationListOptions{
-		Names: cfv3client.Filter{
-			Values: []string{datasourceAppType.Org.ValueString()},
-		},
-	})
+	orgGUID, err := lookupOrgByName(ctx, d.cfClient, d.lookups, datasourceAppType.Org.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding given org", err.Error())
 		return
 	}
-	space, err := d.cfClient.Spaces.Single(ctx, &cfv3client.SpaceListOptions{
-		Names: cfv3client.Filter{
-			Values: []string{datasourceAppType.Space.ValueString()},
-		},
-		OrganizationGUIDs: cfv3client.Filter{
-			Values: []string{org.GUID},
-		},
-	})
+	spaceGUID, err := lookupSpaceByName(ctx, d.cfClient, d.lookups, orgGUID, datasourceAppType.Space.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding given space", err.Error())
 		return
@@ -280,10 +275,10 @@ func (d *appDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 			Values: []string{datasourceAppType.Name.ValueString()},
 		},
 		OrganizationGUIDs: cfv3client.Filter{
-			Values: []string{org.GUID},
+			Values: []string{orgGUID},
 		},
 		SpaceGUIDs: cfv3client.Filter{
-			Values: []string{space.GUID},
+			Values: []string{spaceGUID},
 		},
 	})
 	if err != nil {
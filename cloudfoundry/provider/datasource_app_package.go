@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &appPackageDataSource{}
+	_ datasource.DataSourceWithConfigure = &appPackageDataSource{}
+)
+
+func NewAppPackageDataSource() datasource.DataSource {
+	return &appPackageDataSource{}
+}
+
+type appPackageDataSource struct {
+	cfClient *cfv3client.Client
+}
+
+func (d *appPackageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_package"
+}
+
+func (d *appPackageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information on a package of an app, either by its GUID or the most recent package of an app.",
+
+		Attributes: map[string]schema.Attribute{
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the package.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("app"),
+					}...),
+				},
+			},
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app. If the package GUID is not given, the most recently created package of the app is returned.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the package, either bits or docker.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the package, one of AWAITING_UPLOAD, PROCESSING_UPLOAD, READY, FAILED, COPYING or EXPIRED.",
+				Computed:            true,
+			},
+			"image": schema.StringAttribute{
+				MarkdownDescription: "The docker image of the package. Only set for packages of type docker.",
+				Computed:            true,
+			},
+			"checksum_type": schema.StringAttribute{
+				MarkdownDescription: "The checksum algorithm of the package bits e.g. sha256. Only set for packages of type bits.",
+				Computed:            true,
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The checksum of the package bits. Only set for packages of type bits once the bits are uploaded.",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "The error that occurred while processing the package bits, if any.",
+				Computed:            true,
+			},
+			labelsKey:      datasourceLabelsSchema(),
+			annotationsKey: datasourceAnnotationsSchema(),
+			createdAtKey:   createdAtSchema(),
+			updatedAtKey:   updatedAtSchema(),
+		},
+	}
+}
+
+func (d *appPackageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.cfClient = session.CFClient
+}
+
+func (d *appPackageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppPackageType
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Id.IsNull() {
+		pkg, err := d.cfClient.Packages.Get(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Fetching App Package",
+				"Could not get details of the package with ID "+data.Id.ValueString()+" : "+err.Error(),
+			)
+			return
+		}
+		data, diags = mapAppPackageValuesToType(ctx, pkg)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		listOptions := cfv3client.NewPackageListOptions()
+		listOptions.OrderBy = "-created_at"
+		pkg, err := d.cfClient.Packages.FirstForApp(ctx, data.App.ValueString(), listOptions)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Fetching App Package",
+				"Could not get the packages of app "+data.App.ValueString()+" : "+err.Error(),
+			)
+			return
+		}
+		data, diags = mapAppPackageValuesToType(ctx, pkg)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	tflog.Trace(ctx, "read an app package data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
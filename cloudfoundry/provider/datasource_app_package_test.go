@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AppPackageModelPtr struct {
+	HclType       string
+	HclObjectName string
+	Id            *string
+	App           *string
+}
+
+func hclAppPackage(apmp *AppPackageModelPtr) string {
+	if apmp != nil {
+		s := `
+			{{.HclType}} "cloudfoundry_app_package" "{{.HclObjectName}}" {
+			{{- if .Id}}
+				id  = "{{.Id}}"
+			{{- end -}}
+			{{if .App}}
+				app = "{{.App}}"
+			{{- end }}
+			}`
+		tmpl, err := template.New("app_package").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, apmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return apmp.HclType + ` "cloudfoundry_app_package" ` + apmp.HclObjectName + `  {}`
+}
+
+func TestAppPackageDataSource_Configure(t *testing.T) {
+	var (
+		dataSourceName     = "data.cloudfoundry_app_package.ds"
+		testAppGUID        = "4f1e7b2c-8d3a-4c5e-9b6f-0a1d2e3f4a5b"
+		testPackageGUID    = "0d8e7f6a-5b4c-4d3e-a2f1-0e9d8c7b6a5f"
+		invalidPackageGUID = "00000000-0000-0000-0000-000000000000"
+	)
+	t.Parallel()
+	t.Run("happy path - get package of app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_app_package")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppPackage(&AppPackageModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						App:           &testAppGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "id", testPackageGUID),
+						resource.TestCheckResourceAttr(dataSourceName, "type", "bits"),
+						resource.TestCheckResourceAttr(dataSourceName, "state", "READY"),
+						resource.TestCheckResourceAttr(dataSourceName, "checksum_type", "sha256"),
+						resource.TestCheckResourceAttrSet(dataSourceName, "checksum"),
+						resource.TestCheckNoResourceAttr(dataSourceName, "image"),
+						resource.TestCheckResourceAttr(dataSourceName, "labels.build", "1234"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppPackage(&AppPackageModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						Id:            &testPackageGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "app", testAppGUID),
+						resource.TestCheckResourceAttr(dataSourceName, "state", "READY"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - get unavailable package", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_app_package_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppPackage(&AppPackageModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						Id:            &invalidPackageGUID,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Fetching App Package`),
+				},
+			},
+		})
+	})
+	t.Run("error path - package and app both given", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppPackage(&AppPackageModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						Id:            &testPackageGUID,
+						App:           &testAppGUID,
+					}),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		})
+	})
+}
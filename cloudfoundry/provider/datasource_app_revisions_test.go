@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AppRevisionsModelPtr struct {
+	HclType       string
+	HclObjectName string
+	App           *string
+	Deployed      *bool
+	Revisions     *string
+}
+
+func hclAppRevisions(armp *AppRevisionsModelPtr) string {
+	if armp != nil {
+		s := `
+			{{.HclType}} "cloudfoundry_app_revisions" "{{.HclObjectName}}" {
+			{{- if .App}}
+				app  = "{{.App}}"
+			{{- end -}}
+			{{if .Deployed}}
+				deployed = {{.Deployed}}
+			{{- end -}}
+			{{if .Revisions}}
+				revisions = {{.Revisions}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("app_revisions").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, armp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return armp.HclType + ` "cloudfoundry_app_revisions" ` + armp.HclObjectName + `  {}`
+}
+
+func TestAppRevisionsDataSource_Configure(t *testing.T) {
+	var (
+		dataSourceName = "data.cloudfoundry_app_revisions.ds"
+		testAppGUID    = "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"
+		invalidAppGUID = "00000000-0000-0000-0000-000000000000"
+	)
+	t.Parallel()
+	t.Run("happy path - get app revisions", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_app_revisions")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppRevisions(&AppRevisionsModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						App:           &testAppGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.#", "2"),
+						resource.TestMatchResourceAttr(dataSourceName, "revisions.0.id", regexpValidUUID),
+						resource.TestMatchResourceAttr(dataSourceName, "revisions.0.droplet", regexpValidUUID),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.version", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.description", "Initial revision."),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.deployed", "false"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.environment.MY_ENV", "v1"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.1.version", "2"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.1.deployable", "true"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.1.deployed", "true"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.1.environment.MY_ENV", "v2"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppRevisions(&AppRevisionsModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						App:           &testAppGUID,
+						Deployed:      new(true),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.version", "2"),
+						resource.TestCheckResourceAttr(dataSourceName, "revisions.0.deployed", "true"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - get revisions of non-existent app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_app_revisions_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppRevisions(&AppRevisionsModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						App:           &invalidAppGUID,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Fetching Deployed App Revisions`),
+				},
+			},
+		})
+	})
+}
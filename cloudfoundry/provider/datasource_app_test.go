@@ -38,6 +38,7 @@ data "cloudfoundry_app" "app" {
 						resource.TestCheckResourceAttr(resourceName, "processes.0.readiness_health_check_http_endpoint", "/get"),
 						resource.TestCheckResourceAttr(resourceName, "processes.0.type", "web"),
 						resource.TestCheckResourceAttr(resourceName, "docker_image", "kennethreitz/httpbin"),
+						resource.TestCheckResourceAttr(resourceName, "lifecycle_type", "docker"),
 						resource.TestCheckResourceAttr(resourceName, "labels.%", "2"),
 						resource.TestCheckResourceAttr(resourceName, "annotations.%", "1"),
 					),
@@ -72,6 +73,7 @@ data "cloudfoundry_app" "app" {
 						resource.TestCheckResourceAttr(resourceName, "routes.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "routes.0.protocol", "http1"),
 						resource.TestCheckResourceAttr(resourceName, "environment.MY_ENV", "red"),
+						resource.TestCheckResourceAttr(resourceName, "lifecycle_type", "buildpack"),
 					),
 				},
 			},
@@ -100,6 +100,10 @@ func datasourceAppsSchema() map[string]schema.Attribute {
 			ElementType:         types.StringType,
 			Computed:            true,
 		},
+		"lifecycle_type": schema.StringAttribute{
+			MarkdownDescription: "The lifecycle used to stage the application, one of buildpack, cnb or docker.",
+			Computed:            true,
+		},
 		"docker_image": schema.StringAttribute{
 			MarkdownDescription: "The URL to the docker image with tag",
 			Computed:            true,
@@ -2,16 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
-	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/samber/lo"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -28,6 +27,7 @@ func NewDomainDataSource() datasource.DataSource {
 // Contains reference to the v3 client to be used for making the API calls.
 type DomainDataSource struct {
 	cfClient *client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *DomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +48,7 @@ func (d *DomainDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *DomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -97,28 +98,18 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	domainListOptions := client.NewDomainListOptions()
-	domainListOptions.Names = client.Filter{
-		Values: []string{
-			data.Name.ValueString(),
-		},
-	}
-	domains, err := d.cfClient.Domains.ListAll(ctx, domainListOptions)
-	if err != nil {
+	domain, err := domainLookup(d.cfClient, data.Name.ValueString()).read(ctx, d.lookups)
+	if errors.Is(err, client.ErrExactlyOneResultNotReturned) {
 		resp.Diagnostics.AddError(
-			"API Error Fetching Domain",
-			"Could not get domain with name "+data.Name.ValueString()+" : "+err.Error(),
+			"Unable to find domain in list",
+			fmt.Sprintf("Given name %s not in the list of domains.", data.Name.ValueString()),
 		)
 		return
 	}
-
-	domain, found := lo.Find(domains, func(domain *cfv3resource.Domain) bool {
-		return domain.Name == data.Name.ValueString()
-	})
-	if !found {
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to find domain in list",
-			fmt.Sprintf("Given name %s not in the list of domains.", data.Name.ValueString()),
+			"API Error Fetching Domain",
+			"Could not get domain with name "+data.Name.ValueString()+" : "+err.Error(),
 		)
 		return
 	}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &featureFlagsDataSource{}
+var _ datasource.DataSourceWithConfigure = &featureFlagsDataSource{}
+
+func NewFeatureFlagsDataSource() datasource.DataSource {
+	return &featureFlagsDataSource{}
+}
+
+type featureFlagsDataSource struct {
+	cfClient *cfv3client.Client
+}
+
+func (d *featureFlagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flags"
+}
+
+func (d *featureFlagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.cfClient = session.CFClient
+}
+
+func (d *featureFlagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information of the global Cloud Foundry feature flags.",
+		Attributes: map[string]schema.Attribute{
+			"feature_flags": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of feature flags",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						idKey: schema.StringAttribute{
+							MarkdownDescription: "The name of the feature flag",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the feature flag",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the feature flag is enabled",
+							Computed:            true,
+						},
+						"custom_error_message": schema.StringAttribute{
+							MarkdownDescription: "The error message returned by the API when a user performs an action which is disabled by the feature flag",
+							Computed:            true,
+						},
+						updatedAtKey: updatedAtSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featureFlagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var data featureFlagsDatasourceType
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlags, err := d.cfClient.FeatureFlags.ListAll(ctx, cfv3client.NewFeatureFlagListOptions())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching Feature Flags",
+			"Could not list the feature flags : "+err.Error(),
+		)
+		return
+	}
+
+	data.FeatureFlags = mapFeatureFlagsValuesToType(featureFlags)
+
+	tflog.Trace(ctx, "read the feature flags data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFeatureFlagsDataSource_Configure(t *testing.T) {
+	dataSourceName := "data.cloudfoundry_feature_flags.ds"
+	t.Parallel()
+	t.Run("happy path - get feature flags", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_feature_flags")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+					data "cloudfoundry_feature_flags" "ds" {}
+					`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "feature_flags.#", "17"),
+						resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "feature_flags.*", map[string]string{
+							"name":    "diego_docker",
+							"enabled": "true",
+						}),
+						resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "feature_flags.*", map[string]string{
+							"name":    "user_org_creation",
+							"enabled": "false",
+						}),
+					),
+				},
+			},
+		})
+	})
+}
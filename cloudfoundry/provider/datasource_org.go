@@ -2,15 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
-	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/samber/lo"
 )
 
 var _ datasource.DataSource = &OrgDataSource{}
@@ -22,6 +21,7 @@ func NewOrgDataSource() datasource.DataSource {
 
 type OrgDataSource struct {
 	cfClient *cfv3client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *OrgDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -74,6 +74,7 @@ func (d *OrgDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -85,27 +86,18 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	getOptions := cfv3client.NewOrganizationListOptions()
-	getOptions.Names = cfv3client.Filter{
-		Values: []string{
-			data.Name.ValueString(),
-		},
-	}
-	orgs, err := d.cfClient.Organizations.ListAll(ctx, getOptions)
-	if err != nil {
+	org, err := orgLookup(d.cfClient, data.Name.ValueString()).read(ctx, d.lookups)
+	if errors.Is(err, cfv3client.ErrExactlyOneResultNotReturned) {
 		resp.Diagnostics.AddError(
-			"Unable to fetch org data.",
-			fmt.Sprintf("Request failed with %s.", err.Error()),
+			"Unable to find org data in list",
+			fmt.Sprintf("Given name %s not in the list of orgs.", data.Name.ValueString()),
 		)
 		return
 	}
-	org, found := lo.Find(orgs, func(org *cfv3resource.Organization) bool {
-		return org.Name == data.Name.ValueString()
-	})
-	if !found {
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to find org data in list",
-			fmt.Sprintf("Given name %s not in the list of orgs.", data.Name.ValueString()),
+			"Unable to fetch org data.",
+			fmt.Sprintf("Request failed with %s.", err.Error()),
 		)
 		return
 	}
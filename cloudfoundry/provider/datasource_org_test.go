@@ -17,6 +17,7 @@ type OrgModelPtr struct {
 	Labels        *string
 	Annotations   *string
 	Suspended     *bool
+	Timeouts      *string
 }
 
 func hclOrg(odsmp *OrgModelPtr) string {
@@ -37,6 +38,9 @@ func hclOrg(odsmp *OrgModelPtr) string {
 			{{- end -}}
 			{{if .Annotations}}
 				annotations = {{.Annotations}}
+			{{- end -}}
+			{{if .Timeouts}}
+				timeouts = {{.Timeouts}}
 			{{- end }}
 			}`
 		tmpl, err := template.New("org").Parse(s)
@@ -98,4 +102,31 @@ func TestOrgDataSource_Configure(t *testing.T) {
 			},
 		})
 	})
+	t.Run("get same datasource org twice with lookup cache", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/datasource_org_lookup_cache")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclOrg(&OrgModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds",
+						Name:          new("PerformanceTeamBLR"),
+					}) + hclOrg(&OrgModelPtr{
+						HclType:       hclObjectDataSource,
+						HclObjectName: "ds2",
+						Name:          new("PerformanceTeamBLR"),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("data.cloudfoundry_org.ds", "id", regexpValidUUID),
+						resource.TestCheckResourceAttrPair("data.cloudfoundry_org.ds", "id", "data.cloudfoundry_org.ds2", "id"),
+					),
+				},
+			},
+		})
+	})
 }
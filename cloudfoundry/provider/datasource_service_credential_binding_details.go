@@ -11,8 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -106,50 +108,10 @@ func (d *ServiceCredentialBindingDetailsDataSource) Read(ctx context.Context, re
 		return
 	}
 
-	getOptions := cfv3client.NewServiceCredentialBindingListOptions()
-	getOptions.ServiceInstanceGUIDs = cfv3client.Filter{
-		Values: []string{
-			data.ServiceInstance.ValueString(),
-		},
-	}
-
-	switch data.Type.ValueString() {
-	case "app":
-		if data.App.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("app"),
-				"Missing attribute app",
-				"App GUID is required for app binding",
-			)
-			return
-		}
-		getOptions.AppGUIDs = cfv3client.Filter{
-			Values: []string{
-				data.App.ValueString(),
-			},
-		}
-	case "key":
-		if !data.App.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("app"),
-				"Invalid attribute combination",
-				"App GUID should only be provided for credential bindings of type app",
-			)
-			return
-		}
-		if data.Name.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("name"),
-				"Missing attribute name",
-				"Name is required for a service key",
-			)
-			return
-		}
-		getOptions.Names = cfv3client.Filter{
-			Values: []string{
-				data.Name.ValueString(),
-			},
-		}
+	getOptions, diags := serviceCredentialBindingListOptions(data.ServiceInstance, data.Type, data.App, data.Name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	svcBinding, err := d.cfClient.ServiceCredentialBindings.Single(ctx, getOptions)
@@ -190,3 +152,56 @@ func (d *ServiceCredentialBindingDetailsDataSource) Read(ctx context.Context, re
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &bindingWithCredentials)...)
 }
+
+// Builds the list options to find a single credential binding, app bindings are identified by the app and keys by their name.
+func serviceCredentialBindingListOptions(serviceInstance, bindingType, app, name types.String) (*cfv3client.ServiceCredentialBindingListOptions, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	getOptions := cfv3client.NewServiceCredentialBindingListOptions()
+	getOptions.ServiceInstanceGUIDs = cfv3client.Filter{
+		Values: []string{
+			serviceInstance.ValueString(),
+		},
+	}
+
+	switch bindingType.ValueString() {
+	case "app":
+		if app.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("app"),
+				"Missing attribute app",
+				"App GUID is required for app binding",
+			)
+			return nil, diagnostics
+		}
+		getOptions.AppGUIDs = cfv3client.Filter{
+			Values: []string{
+				app.ValueString(),
+			},
+		}
+	case "key":
+		if !app.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("app"),
+				"Invalid attribute combination",
+				"App GUID should only be provided for credential bindings of type app",
+			)
+			return nil, diagnostics
+		}
+		if name.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Missing attribute name",
+				"Name is required for a service key",
+			)
+			return nil, diagnostics
+		}
+		getOptions.Names = cfv3client.Filter{
+			Values: []string{
+				name.ValueString(),
+			},
+		}
+	}
+
+	return getOptions, diagnostics
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
@@ -17,6 +18,7 @@ func NewServicePlanDataSource() datasource.DataSource {
 
 type ServicePlanDataSource struct {
 	cfClient *cfv3client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *ServicePlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -174,6 +176,7 @@ func (d *ServicePlanDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *ServicePlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -186,47 +189,25 @@ func (d *ServicePlanDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	svcPlanOpts := cfv3client.NewServicePlanListOptions()
-
-	svcPlanOpts.Names = cfv3client.Filter{
-		Values: []string{
-			data.Name.ValueString(),
-		},
-	}
-	svcPlanOpts.ServiceOfferingNames = cfv3client.Filter{
-		Values: []string{
-			data.ServiceOfferingName.ValueString(),
-		},
-	}
-
-	if !data.ServiceBrokerName.IsNull() {
-		svcPlanOpts.ServiceBrokerNames = cfv3client.Filter{
-			Values: []string{
-				data.ServiceBrokerName.ValueString(),
-			},
-		}
-	}
-
-	svcPlans, err := d.cfClient.ServicePlans.ListAll(ctx, svcPlanOpts)
-	if err != nil {
+	svcPlan, err := servicePlanLookup(d.cfClient, data.ServiceBrokerName.ValueString(), data.ServiceOfferingName.ValueString(), data.Name.ValueString()).read(ctx, d.lookups)
+	if errors.Is(err, cfv3client.ErrExactlyOneResultNotReturned) {
 		resp.Diagnostics.AddError(
 			"API Error fetching service plans.",
-			fmt.Sprintf("Request failed with %s.", err.Error()),
+			"Expected exactly one service plan.",
 		)
 		return
 	}
-
-	if len(svcPlans) != 1 {
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"API Error fetching service plans.",
-			fmt.Sprintf("Expected exactly one service plan, got %d.", len(svcPlans)),
+			fmt.Sprintf("Request failed with %s.", err.Error()),
 		)
 		return
 	}
 	// Memorize the Service Broker Name to transfer it to the data object later
 	serviceBrokerName := data.ServiceBrokerName
 	serviceOfferingName := data.ServiceOfferingName
-	data, diags = mapServicePlansValueToData(ctx, svcPlans[0])
+	data, diags = mapServicePlansValueToData(ctx, svcPlan)
 	// Service Broker Name is not transferred to the data object, so we need to set it manually
 	data.ServiceBrokerName = serviceBrokerName
 	data.ServiceOfferingName = serviceOfferingName
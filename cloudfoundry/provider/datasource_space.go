@@ -2,15 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/samber/lo"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
-	"github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -31,6 +30,7 @@ func NewSpaceDataSource() datasource.DataSource {
 // Contains reference to the v3 client to be used for making the API calls.
 type SpaceDataSource struct {
 	cfClient *client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *SpaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -88,6 +88,7 @@ func (d *SpaceDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *SpaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -108,36 +109,19 @@ func (d *SpaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	splo := client.NewSpaceListOptions()
-	splo.OrganizationGUIDs = client.Filter{
-		Values: []string{
-			data.OrgId.ValueString(),
-		},
-	}
-	splo.Names = client.Filter{
-		Values: []string{
-			data.Name.ValueString(),
-		},
-	}
 	//Filtering for spaces under the org with GUID
-	spaces, err := d.cfClient.Spaces.ListAll(ctx, splo)
-
-	if err != nil {
+	space, err := spaceLookup(d.cfClient, data.OrgId.ValueString(), data.Name.ValueString()).read(ctx, d.lookups)
+	if errors.Is(err, client.ErrExactlyOneResultNotReturned) {
 		resp.Diagnostics.AddError(
-			"API Error Fetching Spaces",
-			"Could not get spaces under Organization with ID "+data.OrgId.ValueString()+" : "+err.Error(),
+			"Unable to find space data in list",
+			fmt.Sprintf("Given name %s not in the list of spaces under Org with ID %s.", data.Name.ValueString(), data.OrgId.ValueString()),
 		)
 		return
 	}
-
-	space, found := lo.Find(spaces, func(space *resource.Space) bool {
-		return space.Name == data.Name.ValueString()
-	})
-
-	if !found {
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to find space data in list",
-			fmt.Sprintf("Given name %s not in the list of spaces under Org with ID %s.", data.Name.ValueString(), data.OrgId.ValueString()),
+			"API Error Fetching Spaces",
+			"Could not get spaces under Organization with ID "+data.OrgId.ValueString()+" : "+err.Error(),
 		)
 		return
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
@@ -25,6 +26,7 @@ func NewStackDataSource() datasource.DataSource {
 // Contains reference to the v3 client to be used for making the API calls.
 type StackDataSource struct {
 	cfClient *client.Client
+	lookups  *managers.LookupCache
 }
 
 func (d *StackDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +47,7 @@ func (d *StackDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 	d.cfClient = session.CFClient
+	d.lookups = session.Lookups
 }
 
 func (d *StackDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -88,29 +91,23 @@ func (d *StackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	stlo := client.NewStackListOptions()
-	stlo.Names = client.Filter{
-		Values: []string{
-			data.Name.ValueString(),
-		},
-	}
-	stacks, err := d.cfClient.Stacks.ListAll(ctx, stlo)
-	if err != nil {
+	stack, err := stackLookup(d.cfClient, data.Name.ValueString()).read(ctx, d.lookups)
+	if errors.Is(err, client.ErrExactlyOneResultNotReturned) {
 		resp.Diagnostics.AddError(
-			"API Error Fetching Stack",
-			"Could not get stack with name "+data.Name.ValueString()+" : "+err.Error(),
+			"Unable to find stack in list",
+			fmt.Sprintf("Given name %s not in the list of stacks.", data.Name.ValueString()),
 		)
 		return
 	}
-	if len(stacks) == 0 {
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to find stack in list",
-			fmt.Sprintf("Given name %s not in the list of stacks.", data.Name.ValueString()),
+			"API Error Fetching Stack",
+			"Could not get stack with name "+data.Name.ValueString()+" : "+err.Error(),
 		)
 		return
 	}
 
-	data, diags = mapStackValuesToType(ctx, stacks[0])
+	data, diags = mapStackValuesToType(ctx, stack)
 	resp.Diagnostics.Append(diags...)
 
 	tflog.Trace(ctx, "read a stack data source")
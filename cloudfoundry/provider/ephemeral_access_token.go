@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+)
+
+// Instantiates an access token ephemeral resource.
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+// Contains reference to the session of the provider whose token is handed out.
+type accessTokenEphemeralResource struct {
+	session *managers.Session
+}
+
+func (e *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (e *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Provides the access token the provider uses to authenticate against the Cloud Foundry API, e.g. to call the API or the MTA deploy service from other providers or tools. The token is never persisted in the plan or state.
+
+__Note:__ Ephemeral resources are supported from Terraform version 1.10 onwards. The token is only valid until ` + "`expires_at`" + `, consumers running longer have to request a new one.`,
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token without the token type prefix.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access token e.g. Bearer, to be used as prefix in the Authorization header.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp at which the access token expires.",
+				Computed:            true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Cloud Foundry API.",
+				Computed:            true,
+			},
+			"uaa_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the UAA which issued the access token.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *accessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.session = session
+}
+
+func (e *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	token, err := e.session.Token(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Access Token",
+			"Could not get an access token for the session : "+err.Error(),
+		)
+		return
+	}
+
+	root, err := e.session.CFClient.Root.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching API Root",
+			"Could not get the endpoints of the Cloud Foundry API : "+err.Error(),
+		)
+		return
+	}
+
+	data := accessTokenType{
+		AccessToken: types.StringValue(token.AccessToken),
+		TokenType:   types.StringValue(token.Type()),
+		ExpiresAt:   types.StringNull(),
+		APIURL:      types.StringValue(root.Links.Self.Href),
+		UAAURL:      types.StringValue(root.Links.Uaa.Href),
+	}
+	if !token.Expiry.IsZero() {
+		data.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	tflog.Trace(ctx, "opened an access token ephemeral resource")
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
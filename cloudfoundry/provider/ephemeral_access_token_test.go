@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Renders the ephemeral resource together with an echo provider and resource exposing its values.
+func hclEphemeralAccessToken() string {
+	return `
+		ephemeral "cloudfoundry_access_token" "token" {}
+
+		provider "echo" {
+			data = {
+				access_token = ephemeral.cloudfoundry_access_token.token.access_token
+				token_type   = ephemeral.cloudfoundry_access_token.token.token_type
+				expires_at   = ephemeral.cloudfoundry_access_token.token.expires_at
+				api_url      = ephemeral.cloudfoundry_access_token.token.api_url
+				uaa_url      = ephemeral.cloudfoundry_access_token.token.uaa_url
+			}
+		}
+
+		resource "echo" "test" {}`
+}
+
+func TestAccessTokenEphemeralResource(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - open access token of the session", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/ephemeral_access_token")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEphemeralAccessToken(),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("echo.test", "data.access_token"),
+						resource.TestCheckResourceAttr("echo.test", "data.token_type", "Bearer"),
+						resource.TestMatchResourceAttr("echo.test", "data.expires_at", regexpValidRFC3999Format),
+						resource.TestCheckResourceAttr("echo.test", "data.api_url", "https://api.x.x.x.x.com"),
+						resource.TestCheckResourceAttr("echo.test", "data.uaa_url", "https://uaa.x.x.x.x.com"),
+					),
+				},
+			},
+		})
+	})
+}
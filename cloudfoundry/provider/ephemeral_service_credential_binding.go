@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &serviceCredentialBindingEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &serviceCredentialBindingEphemeralResource{}
+)
+
+// Instantiates a service credential binding ephemeral resource.
+func NewServiceCredentialBindingEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceCredentialBindingEphemeralResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type serviceCredentialBindingEphemeralResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (e *serviceCredentialBindingEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_credential_binding"
+}
+
+func (e *serviceCredentialBindingEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Fetches the credentials of a service credential binding of a particular type (` + "`app`" + ` or ` + "`key`" + `) at plan and apply time without persisting them in the plan or state.
+
+Use it instead of the ` + "`cloudfoundry_service_credential_binding_details`" + ` datasource to pass credentials e.g. to write-only attributes of other providers.
+
+__Note:__ Ephemeral resources are supported from Terraform version 1.10 onwards.`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service credential binding, required for bindings of type `key`.",
+				Optional:            true,
+			},
+			"service_instance": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the service instance.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app which is bound, required for bindings of type `app`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the service credential binding.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(appServiceCredentialBinding, keyServiceCredentialBinding),
+				},
+			},
+			"credential_binding": schema.StringAttribute{
+				MarkdownDescription: "The service credential binding details as JSON.",
+				Computed:            true,
+				Sensitive:           true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the service credential binding.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *serviceCredentialBindingEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.cfClient = session.CFClient
+}
+
+func (e *serviceCredentialBindingEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data serviceCredentialBindingEphemeralType
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getOptions, diags := serviceCredentialBindingListOptions(data.ServiceInstance, data.Type, data.App, data.Name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svcBinding, err := e.cfClient.ServiceCredentialBindings.Single(ctx, getOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching Service Credential Binding.",
+			fmt.Sprintf("Request failed with %s.", err.Error()),
+		)
+		return
+	}
+
+	credentialDetails, err := e.cfClient.ServiceCredentialBindings.GetDetails(ctx, svcBinding.GUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Fetching Service Credential Binding Details.",
+			fmt.Sprintf("Request failed with %s.", err.Error()),
+		)
+		return
+	}
+	credentialJSON, err := json.Marshal(credentialDetails)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Marshalling Service Credential Binding Details.",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(svcBinding.GUID)
+	data.Credentials = jsontypes.NewNormalizedValue(string(credentialJSON))
+
+	tflog.Trace(ctx, "opened a service credential binding ephemeral resource")
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
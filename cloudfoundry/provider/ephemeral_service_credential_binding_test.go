@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+type EphemeralServiceCredentialBindingModelPtr struct {
+	HclObjectName   string
+	Name            *string
+	App             *string
+	ServiceInstance *string
+	Type            *string
+}
+
+// Renders the ephemeral resource together with an echo provider and resource exposing its credentials.
+func hclEphemeralServiceCredentialBinding(escbmp *EphemeralServiceCredentialBindingModelPtr) string {
+	s := `
+		ephemeral "cloudfoundry_service_credential_binding" {{.HclObjectName}} {
+			{{- if .Name}}
+				name = "{{.Name}}"
+			{{- end -}}
+			{{if .App}}
+				app = "{{.App}}"
+			{{- end -}}
+			{{if .ServiceInstance}}
+				service_instance = "{{.ServiceInstance}}"
+			{{- end -}}
+			{{if .Type}}
+				type = "{{.Type}}"
+			{{- end }}
+		}
+
+		provider "echo" {
+			data = {
+				id          = ephemeral.cloudfoundry_service_credential_binding.{{.HclObjectName}}.id
+				credentials = jsondecode(ephemeral.cloudfoundry_service_credential_binding.{{.HclObjectName}}.credential_binding)
+			}
+		}
+
+		resource "echo" "test" {}`
+	tmpl, err := template.New("ephemeral_service_credential_binding").Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, escbmp)
+	if err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestServiceCredentialBindingEphemeralResource(t *testing.T) {
+	var (
+		// in canary -> PerformanceTeamBLR -> tf-space-1
+		testManagedServiceInstanceGUID = "e9ec29ca-993d-42e2-9c5b-cb17b1972cce"
+		testServiceKeyGUID             = "e558565e-3374-429a-a822-99a61853a2da"
+		testServiceKeyName             = "hifi"
+	)
+	t.Parallel()
+	t.Run("happy path - open credentials of a service key", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/ephemeral_service_credential_binding")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEphemeralServiceCredentialBinding(&EphemeralServiceCredentialBindingModelPtr{
+						HclObjectName:   "key",
+						Name:            new(testServiceKeyName),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("echo.test", "data.id", testServiceKeyGUID),
+						resource.TestCheckResourceAttr("echo.test", "data.credentials.credentials.username", "hifi-user"),
+						resource.TestCheckResourceAttrSet("echo.test", "data.credentials.credentials.password"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - service key does not exist", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/ephemeral_service_credential_binding_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEphemeralServiceCredentialBinding(&EphemeralServiceCredentialBindingModelPtr{
+						HclObjectName:   "key",
+						Name:            new("invalid-key-name"),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+					}),
+					ExpectError: regexp.MustCompile(`API Error Fetching Service Credential Binding`),
+				},
+			},
+		})
+	})
+	t.Run("error path - service key without name", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/ephemeral_service_credential_binding_invalid")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProvidersWithEcho(rec.GetDefaultClient()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEphemeralServiceCredentialBinding(&EphemeralServiceCredentialBindingModelPtr{
+						HclObjectName:   "key",
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+					}),
+					ExpectError: regexp.MustCompile(`Missing attribute name`),
+				},
+			},
+		})
+	})
+}
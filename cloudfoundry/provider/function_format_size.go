@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &formatSizeFunction{}
+
+// Instantiates the format_size provider function.
+func NewFormatSizeFunction() function.Function {
+	return &formatSizeFunction{}
+}
+
+type formatSizeFunction struct{}
+
+func (f *formatSizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_size"
+}
+
+func (f *formatSizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Formats megabytes as a Cloud Foundry byte size",
+		MarkdownDescription: "Formats a number of megabytes as a byte size with the largest unit that represents it exactly, e.g. `1024` becomes `1G` and `1536` becomes `1536M`. " +
+			"The result is the inverse of `parse_size` and can be used for `memory`, `disk_quota` and quotas.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "megabytes",
+				MarkdownDescription: "The size in megabytes, must not be negative.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *formatSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var megabytes int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &megabytes))
+	if resp.Error != nil {
+		return
+	}
+	if megabytes < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Size must not be negative, got %d", megabytes))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, formatMegabytes(megabytes)))
+}
+
+// Formats megabytes with the largest of the units M, G and T that divides them without remainder.
+func formatMegabytes(megabytes int64) string {
+	value, unit := megabytes, "M"
+	for _, next := range []string{"G", "T"} {
+		if value == 0 || value%1024 != 0 {
+			break
+		}
+		value, unit = value/1024, next
+	}
+	return fmt.Sprintf("%d%s", value, unit)
+}
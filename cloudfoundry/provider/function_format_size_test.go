@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFormatSizeFunction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - format sizes with the largest exact unit", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "gigabyte" {
+							value = provider::cloudfoundry::format_size(1024)
+						}
+						output "megabyte" {
+							value = provider::cloudfoundry::format_size(1536)
+						}
+						output "terabyte" {
+							value = provider::cloudfoundry::format_size(2097152)
+						}
+						output "zero" {
+							value = provider::cloudfoundry::format_size(0)
+						}
+						output "roundtrip" {
+							value = provider::cloudfoundry::format_size(provider::cloudfoundry::parse_size("4096M"))
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("gigabyte", "1G"),
+						resource.TestCheckOutput("megabyte", "1536M"),
+						resource.TestCheckOutput("terabyte", "2T"),
+						resource.TestCheckOutput("zero", "0M"),
+						resource.TestCheckOutput("roundtrip", "4G"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - negative size", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "size" {
+							value = provider::cloudfoundry::format_size(-1)
+						}`,
+					ExpectError: regexp.MustCompile(`Size must not be negative`),
+				},
+			},
+		})
+	})
+}
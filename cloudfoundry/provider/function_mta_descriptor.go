@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/mta"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &mtaDescriptorFunction{}
+
+// Instantiates the mta_descriptor provider function.
+func NewMtaDescriptorFunction() function.Function {
+	return &mtaDescriptorFunction{}
+}
+
+type mtaDescriptorFunction struct{}
+
+var mtaDescriptorAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"version":        types.StringType,
+	"namespace":      types.StringType,
+	"schema_version": types.StringType,
+	"modules":        types.DynamicType,
+	"resources":      types.DynamicType,
+	"parameters":     types.DynamicType,
+}
+
+func (f *mtaDescriptorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mta_descriptor"
+}
+
+func (f *mtaDescriptorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Reads the deployment descriptor of an MTA archive",
+		MarkdownDescription: "Reads the deployment descriptor `META-INF/mtad.yaml` from a local MTA archive, the same way `cloudfoundry_mta` determines the MTA ID before a deployment. " +
+			"The result is an object with the attributes `id`, `version`, `namespace` and `schema_version` as well as `modules`, `resources` and `parameters`, which hold the corresponding sections of the descriptor as is. " +
+			"Attributes not present in the descriptor are null respectively empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "The path to the MTA archive, relative paths are resolved against the working directory of Terraform.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: mtaDescriptorAttrTypes,
+		},
+	}
+}
+
+func (f *mtaDescriptorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	descriptor, err := mta.GetMtaDescriptorFromArchive(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to read MTA descriptor from %q: %s", path, err.Error()))
+		return
+	}
+
+	modules, err := descriptorSectionsToValue(descriptor.Modules)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to convert modules of MTA %q: %s", descriptor.ID, err.Error()))
+		return
+	}
+	resources, err := descriptorSectionsToValue(descriptor.Resources)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to convert resources of MTA %q: %s", descriptor.ID, err.Error()))
+		return
+	}
+	parameters, err := yamlToAttrValue(descriptor.Parameters)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to convert parameters of MTA %q: %s", descriptor.ID, err.Error()))
+		return
+	}
+	if descriptor.Parameters == nil {
+		parameters = types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})
+	}
+
+	result := types.ObjectValueMust(mtaDescriptorAttrTypes, map[string]attr.Value{
+		"id":             types.StringValue(descriptor.ID),
+		"version":        stringOrNull(descriptor.Version),
+		"namespace":      stringOrNull(descriptor.Namespace),
+		"schema_version": stringOrNull(descriptor.SchemaVersion),
+		"modules":        types.DynamicValue(modules),
+		"resources":      types.DynamicValue(resources),
+		"parameters":     types.DynamicValue(parameters),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// Converts the list of modules or resources of a descriptor to a tuple of objects.
+func descriptorSectionsToValue(sections []map[string]any) (attr.Value, error) {
+	items := make([]any, 0, len(sections))
+	for _, section := range sections {
+		items = append(items, section)
+	}
+	return yamlToAttrValue(items)
+}
+
+// Converts a value decoded from YAML into a Terraform value, maps become objects and sequences tuples
+// as their elements may differ in type.
+func yamlToAttrValue(value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(v))), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v)), nil
+	case uint64:
+		return types.NumberValue(new(big.Float).SetUint64(v)), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case time.Time:
+		return types.StringValue(v.Format(time.RFC3339)), nil
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem, err := yamlToAttrValue(item)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return tuple, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			elem, err := yamlToAttrValue(item)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[key] = elem.Type(context.Background())
+			attrs[key] = elem
+		}
+		object, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return object, nil
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = item
+		}
+		return yamlToAttrValue(converted)
+	default:
+		return nil, fmt.Errorf("unsupported value %v of type %T", v, v)
+	}
+}
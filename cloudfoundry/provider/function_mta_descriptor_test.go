@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestMtaDescriptorFunction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - read descriptor of an archive", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						locals {
+							descriptor = provider::cloudfoundry::mta_descriptor("../../assets/my-mta_1.0.0.mtar")
+						}
+						output "id" {
+							value = local.descriptor.id
+						}
+						output "version" {
+							value = local.descriptor.version
+						}
+						output "schema_version" {
+							value = local.descriptor.schema_version
+						}
+						output "namespace_null" {
+							value = local.descriptor.namespace == null
+						}
+						output "modules" {
+							value = join(",", local.descriptor.modules[*].name)
+						}
+						output "module_instances" {
+							value = local.descriptor.modules[0].parameters.instances
+						}
+						output "resource_service" {
+							value = local.descriptor.resources[0].parameters.service
+						}
+						output "service_plan_null" {
+							value = local.descriptor.resources[0].parameters["service-plan"] == null
+						}
+						output "parameters" {
+							value = length(local.descriptor.parameters)
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("id", "my-mta"),
+						resource.TestCheckOutput("version", "1.0.0"),
+						resource.TestCheckOutput("schema_version", "3.3.0"),
+						resource.TestCheckOutput("namespace_null", "true"),
+						resource.TestCheckOutput("modules", "my-app"),
+						resource.TestCheckOutput("module_instances", "1"),
+						resource.TestCheckOutput("resource_service", "application-logs"),
+						resource.TestCheckOutput("service_plan_null", "true"),
+						resource.TestCheckOutput("parameters", "0"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - archive does not exist", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "descriptor" {
+							value = provider::cloudfoundry::mta_descriptor("../../assets/missing.mtar")
+						}`,
+					ExpectError: regexp.MustCompile(`Unable to read MTA descriptor`),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseRouteFunction{}
+
+// Instantiates the parse_route provider function.
+func NewParseRouteFunction() function.Function {
+	return &parseRouteFunction{}
+}
+
+type parseRouteFunction struct{}
+
+var parseRouteAttrTypes = map[string]attr.Type{
+	"host":   types.StringType,
+	"domain": types.StringType,
+	"path":   types.StringType,
+	"port":   types.Int64Type,
+}
+
+func (f *parseRouteFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_route"
+}
+
+func (f *parseRouteFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a Cloud Foundry route URL into its parts",
+		MarkdownDescription: "Splits a route URL as returned in the `url` attribute of `cloudfoundry_route` or used in app manifests, e.g. `host.domain/path` or `domain:port`, into an object with the attributes `host`, `domain`, `path` and `port`. " +
+			"Attributes not present in the URL are null and an optional `http://`, `https://` or `tcp://` scheme is ignored.\n\n" +
+			"As a host cannot be told apart from a subdomain without looking up the domains of the platform, the domain of the route has to be given. The function fails if the URL is not in the domain or the part before the domain is not a single host label. Routes with a port are TCP routes which have no host.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The route URL, e.g. `myapp.example.com/api`.",
+			},
+			function.StringParameter{
+				Name:                "domain",
+				MarkdownDescription: "The domain of the route, e.g. `example.com`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseRouteAttrTypes,
+		},
+	}
+}
+
+func (f *parseRouteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url, domain string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	route, err := parseRouteURL(url, domain)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, route))
+}
+
+// Splits a route URL in the given domain into host, domain, path and port, absent parts are null.
+func parseRouteURL(url, domain string) (types.Object, error) {
+	host, path, port := types.StringNull(), types.StringNull(), types.Int64Null()
+
+	address := url
+	for _, scheme := range []string{"http://", "https://", "tcp://"} {
+		if len(address) >= len(scheme) && strings.EqualFold(address[:len(scheme)], scheme) {
+			address = address[len(scheme):]
+			break
+		}
+	}
+
+	if i := strings.Index(address, "/"); i >= 0 {
+		address, path = address[:i], types.StringValue(address[i:])
+	}
+	portValue := ""
+	if i := strings.LastIndex(address, ":"); i >= 0 {
+		address, portValue = address[:i], address[i+1:]
+	} else if p := path.ValueString(); strings.Contains(p, ":") {
+		i := strings.LastIndex(p, ":")
+		path, portValue = types.StringValue(p[:i]), p[i+1:]
+	}
+	if path.ValueString() == "/" {
+		path = types.StringNull()
+	}
+	if portValue != "" {
+		p, err := strconv.ParseInt(portValue, 10, 64)
+		if err != nil || p < 1 || p > 65535 {
+			return types.ObjectNull(parseRouteAttrTypes), fmt.Errorf("invalid port %q in route %q", portValue, url)
+		}
+		port = types.Int64Value(p)
+	}
+
+	for _, label := range strings.Split(address, ".") {
+		if label == "" {
+			return types.ObjectNull(parseRouteAttrTypes), fmt.Errorf("invalid domain %q in route %q", address, url)
+		}
+	}
+	switch {
+	case strings.EqualFold(address, domain):
+	case len(address) > len(domain)+1 && strings.EqualFold(address[len(address)-len(domain)-1:], "."+domain):
+		hostValue := address[:len(address)-len(domain)-1]
+		if strings.Contains(hostValue, ".") {
+			return types.ObjectNull(parseRouteAttrTypes), fmt.Errorf("%q of route %q is not a single host label, the route is in a subdomain of %q", hostValue, url, domain)
+		}
+		if !port.IsNull() {
+			return types.ObjectNull(parseRouteAttrTypes), fmt.Errorf("route %q has a port and therefore cannot have a host", url)
+		}
+		host, address = types.StringValue(hostValue), address[len(hostValue)+1:]
+	default:
+		return types.ObjectNull(parseRouteAttrTypes), fmt.Errorf("route %q is not in the domain %q", url, domain)
+	}
+
+	return types.ObjectValueMust(parseRouteAttrTypes, map[string]attr.Value{
+		"host":   host,
+		"domain": types.StringValue(address),
+		"path":   path,
+		"port":   port,
+	}), nil
+}
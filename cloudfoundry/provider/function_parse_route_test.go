@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseRouteFunction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - parse http and tcp routes", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						locals {
+							http  = provider::cloudfoundry::parse_route("https://myapp.apps.example.com/api/v1", "apps.example.com")
+							apex  = provider::cloudfoundry::parse_route("example.co.uk", "example.co.uk")
+							tcp   = provider::cloudfoundry::parse_route("tcp.example.com:1024", "tcp.example.com")
+						}
+						output "http_host" {
+							value = local.http.host
+						}
+						output "http_domain" {
+							value = local.http.domain
+						}
+						output "http_path" {
+							value = local.http.path
+						}
+						output "http_port_null" {
+							value = local.http.port == null
+						}
+						output "apex_host_null" {
+							value = local.apex.host == null
+						}
+						output "apex_domain" {
+							value = local.apex.domain
+						}
+						output "tcp_host_null" {
+							value = local.tcp.host == null
+						}
+						output "tcp_domain" {
+							value = local.tcp.domain
+						}
+						output "tcp_port" {
+							value = local.tcp.port
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("http_host", "myapp"),
+						resource.TestCheckOutput("http_domain", "apps.example.com"),
+						resource.TestCheckOutput("http_path", "/api/v1"),
+						resource.TestCheckOutput("http_port_null", "true"),
+						resource.TestCheckOutput("apex_host_null", "true"),
+						resource.TestCheckOutput("apex_domain", "example.co.uk"),
+						resource.TestCheckOutput("tcp_host_null", "true"),
+						resource.TestCheckOutput("tcp_domain", "tcp.example.com"),
+						resource.TestCheckOutput("tcp_port", "1024"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid routes", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "route" {
+							value = provider::cloudfoundry::parse_route("myapp.example.com/path", "apps.example.com")
+						}`,
+					ExpectError: regexp.MustCompile(`is not in\s+the domain "apps.example.com"`),
+				},
+				{
+					Config: hclProvider(nil) + `
+						output "route" {
+							value = provider::cloudfoundry::parse_route("myapp.apps.example.com", "example.com")
+						}`,
+					ExpectError: regexp.MustCompile(`not a single host label`),
+				},
+				{
+					Config: hclProvider(nil) + `
+						output "route" {
+							value = provider::cloudfoundry::parse_route("myapp.tcp.example.com:1024", "tcp.example.com")
+						}`,
+					ExpectError: regexp.MustCompile(`has a\s+port and therefore cannot have a host`),
+				},
+				{
+					Config: hclProvider(nil) + `
+						output "route" {
+							value = provider::cloudfoundry::parse_route("tcp.example.com:http", "tcp.example.com")
+						}`,
+					ExpectError: regexp.MustCompile(`invalid port "http"`),
+				},
+			},
+		})
+	})
+}
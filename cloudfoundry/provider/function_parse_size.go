@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &parseSizeFunction{}
+
+// Instantiates the parse_size provider function.
+func NewParseSizeFunction() function.Function {
+	return &parseSizeFunction{}
+}
+
+type parseSizeFunction struct{}
+
+func (f *parseSizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_size"
+}
+
+func (f *parseSizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a Cloud Foundry byte size to megabytes",
+		MarkdownDescription: "Converts a byte size with a unit as used for `memory`, `disk_quota` and quotas, e.g. `1G` or `512M`, to whole megabytes. " +
+			"Units are case insensitive and 1024-based, supported are `B`, `K`, `KB`, `M`, `MB`, `G`, `GB`, `T` and `TB`. Fractions of a megabyte are rounded down just like Cloud Foundry does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "size",
+				MarkdownDescription: "The byte size with unit, e.g. `1G`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *parseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	_, unit, err := splitValueAndUnit(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse size %q, expected a number followed by a unit e.g. 1G", size))
+		return
+	}
+	if _, ok := sizeUnitExponents[unit]; !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unsupported unit %q in size %q, expected one of B, K, KB, M, MB, G, GB, T, TB", unit, size))
+		return
+	}
+	megabytes, err := convertToMegabytes(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(megabytes)))
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseSizeFunction(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - parse sizes with different units", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "gigabyte" {
+							value = provider::cloudfoundry::parse_size("1G")
+						}
+						output "megabyte" {
+							value = provider::cloudfoundry::parse_size("512mb")
+						}
+						output "terabyte" {
+							value = provider::cloudfoundry::parse_size("2T")
+						}
+						output "kilobyte" {
+							value = provider::cloudfoundry::parse_size("1536K")
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("gigabyte", "1024"),
+						resource.TestCheckOutput("megabyte", "512"),
+						resource.TestCheckOutput("terabyte", "2097152"),
+						resource.TestCheckOutput("kilobyte", "1"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid sizes", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+						output "size" {
+							value = provider::cloudfoundry::parse_size("1024")
+						}`,
+					ExpectError: regexp.MustCompile(`Unable to parse size`),
+				},
+				{
+					Config: hclProvider(nil) + `
+						output "size" {
+							value = provider::cloudfoundry::parse_size("1X")
+						}`,
+					ExpectError: regexp.MustCompile(`Unsupported unit "X"`),
+				},
+			},
+		})
+	})
+}
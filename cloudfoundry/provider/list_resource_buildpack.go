@@ -6,6 +6,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -108,6 +109,12 @@ func (r *buildpackListResource) List(
 				resBuildpack, diags := mapBuildpackValuesToType(ctx, buildpack)
 				result.Diagnostics.Append(diags...)
 
+				resBuildpack.Timeouts = timeoutsNull(timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resBuildpack)...)
 				}
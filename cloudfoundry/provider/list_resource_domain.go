@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -121,9 +122,14 @@ func (r *domainListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("domain_guid"), domain.GUID)
 
 			if req.IncludeResource {
-				resDomain, diags := mapDomainValuesToType(ctx, domain)
+				domainData, diags := mapDomainValuesToType(ctx, domain)
 				result.Diagnostics.Append(diags...)
 
+				resDomain := domainData.Expand()
+				resDomain.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resDomain)...)
 				}
@@ -6,6 +6,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -79,9 +80,14 @@ func (r *orgListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("org_guid"), org.GUID)
 
 			if req.IncludeResource {
-				resOrg, diags := mapOrgValuesToType(ctx, org)
+				orgData, diags := mapOrgValuesToType(ctx, org)
 				result.Diagnostics.Append(diags...)
 
+				resOrg := orgData.Expand()
+				resOrg.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resOrg)...)
 				}
@@ -6,6 +6,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -79,9 +80,14 @@ func (r *orgQuotaListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("org_quota_guid"), orgQuota.GUID)
 
 			if req.IncludeResource {
-				resOrgQuota, diags := mapOrgQuotaValuesToType(orgQuota)
+				orgQuotaData, diags := mapOrgQuotaValuesToType(orgQuota)
 				result.Diagnostics.Append(diags...)
 
+				resOrgQuota := orgQuotaData.Expand()
+				resOrgQuota.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resOrgQuota)...)
 				}
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
@@ -184,8 +185,12 @@ func (r *orgRoleListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("role_guid"), role.GUID)
 
 			if req.IncludeResource {
-				resRole := mapRoleValuesToType(role)
-				result.Diagnostics.Append(result.Resource.Set(ctx, resRole.ReduceToOrgRole())...)
+				roleData := mapRoleValuesToType(role)
+				resRole := roleData.ReduceToOrgRole()
+				resRole.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+				result.Diagnostics.Append(result.Resource.Set(ctx, resRole)...)
 			}
 
 			if !push(result) {
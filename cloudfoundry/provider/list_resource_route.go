@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -162,9 +163,14 @@ func (r *routeListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("route_guid"), route.GUID)
 
 			if req.IncludeResource {
-				resRoute, diags := mapRouteValuesToType(ctx, route)
+				routeData, diags := mapRouteValuesToType(ctx, route)
 				result.Diagnostics.Append(diags...)
 
+				resRoute := routeData.Expand()
+				resRoute.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resRoute)...)
 				}
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -124,9 +125,14 @@ func (r *securityGroupListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("security_group_guid"), sg.GUID)
 
 			if req.IncludeResource {
-				resSG, diags := mapSecurityGroupValuesToType(ctx, sg)
+				sgData, diags := mapSecurityGroupValuesToType(ctx, sg)
 				result.Diagnostics.Append(diags...)
 
+				resSG := sgData.Expand()
+				resSG.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resSG)...)
 				}
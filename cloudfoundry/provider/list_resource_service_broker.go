@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -109,6 +110,12 @@ func (r *serviceBrokerListResource) List(
 				resSB, diags := mapServiceBrokerValuesToType(ctx, sb)
 				result.Diagnostics.Append(diags...)
 
+				resSB.Timeouts = timeoutsNull(timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resSB)...)
 				}
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -120,9 +121,16 @@ func (r *serviceCredentialBindingListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("service_credential_binding_guid"), binding.GUID)
 
 			if req.IncludeResource {
-				resBinding, diags := mapServiceCredentialBindingValuesToType(ctx, binding)
+				bindingData, diags := mapServiceCredentialBindingValuesToType(ctx, binding)
 				result.Diagnostics.Append(diags...)
 
+				resBinding := bindingData.Expand()
+				resBinding.Timeouts = timeoutsNull(timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resBinding)...)
 				}
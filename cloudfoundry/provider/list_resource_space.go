@@ -6,6 +6,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -149,10 +150,15 @@ func (r *spaceListResource) List(
 					stream.Results = list.ListResultsStreamDiagnostics(diags)
 					return
 				}
-				resSpace, diags := mapSpaceValuesToType(ctx, space, sshEnabled, isolationSegment)
+				spaceData, diags := mapSpaceValuesToType(ctx, space, sshEnabled, isolationSegment)
 
 				result.Diagnostics.Append(diags...)
 
+				resSpace := spaceData.Expand()
+				resSpace.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				// Set the resource information on the result
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resSpace)...)
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -118,9 +119,14 @@ func (r *spaceQuotaListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("space_quota_guid"), spaceQuota.GUID)
 
 			if req.IncludeResource {
-				resSpaceQuota, diags := mapSpaceQuotaValuesToType(spaceQuota)
+				spaceQuotaData, diags := mapSpaceQuotaValuesToType(spaceQuota)
 				result.Diagnostics.Append(diags...)
 
+				resSpaceQuota := spaceQuotaData.Expand()
+				resSpaceQuota.Timeouts = timeoutsNull(timeouts.Opts{
+					Delete: true,
+				})
+
 				if !result.Diagnostics.HasError() {
 					result.Diagnostics.Append(result.Resource.Set(ctx, resSpaceQuota)...)
 				}
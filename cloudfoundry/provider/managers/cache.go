@@ -0,0 +1,132 @@
+package managers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+)
+
+const DefaultLookupCacheTTL = 5 * time.Minute
+
+// LookupCache keeps the results of name lookups, e.g. the GUID of the org or the space of a given name, which are repeated by many
+// data sources and resources within one provider process. Any write to the Cloud Foundry API purges the cache, so that
+// lookups never return a result which the provider changed itself. Concurrent lookups of the same key share one request.
+type LookupCache struct {
+	ttl      time.Duration
+	inflight singleflight.Group
+
+	mutex      sync.Mutex
+	entries    map[string]lookupEntry
+	generation uint64
+	hits       int
+	misses     int
+}
+
+type lookupEntry struct {
+	value   any
+	expires time.Time
+}
+
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		entries: map[string]lookupEntry{},
+	}
+}
+
+// Lookup returns the cached result of the lookup of kind with the given key, e.g. the name of the resource and of its
+// parent. Otherwise resolve is called and a successful result is cached. A nil cache always calls resolve.
+func Lookup[T any](ctx context.Context, c *LookupCache, kind string, key string, resolve func() (T, error)) (T, error) {
+	if c == nil {
+		return resolve()
+	}
+	cacheKey := kind + "/" + key
+
+	c.mutex.Lock()
+	entry, found := c.entries[cacheKey]
+	if found && time.Now().Before(entry.expires) {
+		c.hits++
+		c.logMetrics(ctx, kind, key, true)
+		c.mutex.Unlock()
+		return entry.value.(T), nil
+	}
+	c.mutex.Unlock()
+
+	resolved := false
+	value, err, _ := c.inflight.Do(cacheKey, func() (any, error) {
+		resolved = true
+		c.mutex.Lock()
+		generation := c.generation
+		c.mutex.Unlock()
+
+		value, err := resolve()
+		if err != nil {
+			return value, err
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		// A write during the lookup might have changed the result, it is not cached then.
+		if generation == c.generation {
+			c.entries[cacheKey] = lookupEntry{value: value, expires: time.Now().Add(c.ttl)}
+		}
+		return value, nil
+	})
+
+	c.mutex.Lock()
+	if resolved {
+		c.misses++
+	} else {
+		c.hits++
+	}
+	c.logMetrics(ctx, kind, key, !resolved)
+	c.mutex.Unlock()
+	return value.(T), err
+}
+
+// Purge drops all cached lookups.
+func (c *LookupCache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	clear(c.entries)
+}
+
+func (c *LookupCache) logMetrics(ctx context.Context, kind string, key string, hit bool) {
+	tflog.Debug(ctx, "lookup cache", map[string]any{
+		"kind":    kind,
+		"key":     key,
+		"hit":     hit,
+		"hits":    c.hits,
+		"misses":  c.misses,
+		"entries": len(c.entries),
+	})
+}
+
+// purgeTransport purges the lookup cache whenever a request may have changed resources on the platform.
+type purgeTransport struct {
+	next  http.RoundTripper
+	cache *LookupCache
+}
+
+func newPurgeTransport(next http.RoundTripper, cache *LookupCache) http.RoundTripper {
+	if cache == nil {
+		return next
+	}
+	return &purgeTransport{next: next, cache: cache}
+}
+
+func (t *purgeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		// Purge before and after the request, so that no lookup running concurrently caches a stale result.
+		t.cache.Purge()
+		defer t.cache.Purge()
+	}
+	return t.next.RoundTrip(req)
+}
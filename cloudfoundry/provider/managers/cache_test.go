@@ -0,0 +1,143 @@
+package managers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	counter := func(calls *int, value string) func() (string, error) {
+		return func() (string, error) {
+			*calls++
+			return value, nil
+		}
+	}
+	t.Run("happy path - cache hit", func(t *testing.T) {
+		cache := NewLookupCache(time.Minute)
+		calls := 0
+
+		for range 3 {
+			value, err := Lookup(ctx, cache, "org", "my-org", counter(&calls, "guid-1"))
+			assert.NoError(t, err)
+			assert.Equal(t, "guid-1", value)
+		}
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 2, cache.hits)
+		assert.Equal(t, 1, cache.misses)
+	})
+	t.Run("happy path - kinds and keys are separate", func(t *testing.T) {
+		cache := NewLookupCache(time.Minute)
+		calls := 0
+
+		_, _ = Lookup(ctx, cache, "org", "my-org", counter(&calls, "guid-1"))
+		_, _ = Lookup(ctx, cache, "org", "other-org", counter(&calls, "guid-2"))
+		value, _ := Lookup(ctx, cache, "space", "my-org", counter(&calls, "guid-3"))
+		assert.Equal(t, "guid-3", value)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("happy path - concurrent lookups share one call", func(t *testing.T) {
+		cache := NewLookupCache(time.Minute)
+		var calls atomic.Int32
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				value, err := Lookup(ctx, cache, "org", "my-org", func() (string, error) {
+					calls.Add(1)
+					time.Sleep(20 * time.Millisecond)
+					return "guid-1", nil
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, "guid-1", value)
+			})
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, 9, cache.hits)
+		assert.Equal(t, 1, cache.misses)
+	})
+	t.Run("happy path - disabled cache", func(t *testing.T) {
+		calls := 0
+
+		_, _ = Lookup(ctx, nil, "org", "my-org", counter(&calls, "guid-1"))
+		_, _ = Lookup(ctx, nil, "org", "my-org", counter(&calls, "guid-1"))
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("happy path - expired entry", func(t *testing.T) {
+		cache := NewLookupCache(time.Millisecond)
+		calls := 0
+
+		_, _ = Lookup(ctx, cache, "org", "my-org", counter(&calls, "guid-1"))
+		time.Sleep(5 * time.Millisecond)
+		_, _ = Lookup(ctx, cache, "org", "my-org", counter(&calls, "guid-1"))
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("error path - errors are not cached", func(t *testing.T) {
+		cache := NewLookupCache(time.Minute)
+		calls := 0
+		failing := func() (string, error) {
+			calls++
+			return "", errors.New("not found")
+		}
+
+		_, err := Lookup(ctx, cache, "org", "my-org", failing)
+		assert.Error(t, err)
+		_, err = Lookup(ctx, cache, "org", "my-org", failing)
+		assert.Error(t, err)
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("happy path - purge during lookup", func(t *testing.T) {
+		cache := NewLookupCache(time.Minute)
+		calls := 0
+
+		_, _ = Lookup(ctx, cache, "org", "my-org", func() (string, error) {
+			calls++
+			cache.Purge()
+			return "stale", nil
+		})
+		value, _ := Lookup(ctx, cache, "org", "my-org", counter(&calls, "fresh"))
+		assert.Equal(t, "fresh", value)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestPurgeTransport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	cache := NewLookupCache(time.Minute)
+	client := &http.Client{Transport: newPurgeTransport(http.DefaultTransport, cache)}
+	calls := 0
+	lookup := func() {
+		_, _ = Lookup(ctx, cache, "org", "my-org", func() (string, error) {
+			calls++
+			return "guid-1", nil
+		})
+	}
+
+	lookup()
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	lookup()
+	assert.Equal(t, 1, calls)
+
+	req, _ := http.NewRequest(http.MethodPatch, server.URL, nil)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	lookup()
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,134 @@
+package managers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// credentialSourceRefreshToken stands in for the refresh token when the credential source holds an access token only,
+// as go-cfclient does not ask for a new access token on expiry without a refresh token.
+const credentialSourceRefreshToken = "credential-source"
+
+// credentialTokens are the tokens which the token file holds or the credential process prints, e.g.
+// {"access_token": "bearer eyJhbGciOi...", "refresh_token": "..."}
+type credentialTokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// credentialSource reads the Cloud Foundry tokens from a file or the output of a command, which are kept up to date
+// by an external process, e.g. a secrets agent rotating the tokens on disk.
+type credentialSource struct {
+	file    string
+	process string
+}
+
+func newCredentialSource(file, process string) *credentialSource {
+	return &credentialSource{file: file, process: process}
+}
+
+func (s *credentialSource) Credentials(ctx context.Context) (credentialTokens, error) {
+	var output []byte
+	var err error
+	switch {
+	case s.file != "":
+		if output, err = os.ReadFile(s.file); err != nil {
+			return credentialTokens{}, fmt.Errorf("unable to read token_file: %w", err)
+		}
+	case s.process != "":
+		if output, err = s.run(ctx); err != nil {
+			return credentialTokens{}, fmt.Errorf("unable to run credential_process: %w", err)
+		}
+	default:
+		return credentialTokens{}, errors.New("neither a token file nor a credential process is configured")
+	}
+
+	var credentials credentialTokens
+	if err = json.Unmarshal(output, &credentials); err != nil {
+		return credentialTokens{}, fmt.Errorf("invalid credentials, expected a JSON object with access_token and refresh_token: %w", err)
+	}
+	credentials.AccessToken = strings.TrimSpace(credentials.AccessToken)
+	credentials.RefreshToken = strings.TrimSpace(credentials.RefreshToken)
+	if credentials.AccessToken == "" && credentials.RefreshToken == "" {
+		return credentialTokens{}, errors.New("invalid credentials, neither access_token nor refresh_token is set")
+	}
+	return credentials, nil
+}
+
+// Runs the credential process with the shell of the platform, so that it can be given with arguments and quotes as
+// on the command line.
+func (s *credentialSource) run(ctx context.Context) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", s.process)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", s.process)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil && stderr.Len() > 0 {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return output, err
+}
+
+// Returns a transport which answers the refresh token requests of go-cfclient with the current tokens of the credential
+// source. go-cfclient is created with the tokens of the source and asks UAA for a new access token when it expires;
+// by then the source usually holds a new access token, which is handed out without asking UAA. Otherwise the refresh
+// token of the source is sent to UAA instead of the one go-cfclient was created with, which may be rotated already.
+func newCredentialTransport(next http.RoundTripper, source *credentialSource) http.RoundTripper {
+	return newTokenTransport(next, "refresh_token", func(req *http.Request, form url.Values) (*http.Response, error) {
+		credentials, err := source.Credentials(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		accessToken := credentials.AccessToken
+		if _, token, found := strings.Cut(accessToken, " "); found {
+			accessToken = token
+		}
+		if expiry := jwtExpiry(accessToken); time.Now().Add(time.Minute).Before(expiry) {
+			return tokenResponse(req, accessToken, credentials.RefreshToken, time.Until(expiry))
+		}
+		if credentials.RefreshToken == "" {
+			return nil, errors.New("the access token of the credential source has expired and no refresh token is available")
+		}
+		form.Set("refresh_token", credentials.RefreshToken)
+		return nil, nil
+	})
+}
+
+// Returns a UAA token response with the given tokens.
+func tokenResponse(req *http.Request, accessToken, refreshToken string, expiresIn time.Duration) (*http.Response, error) {
+	body, err := json.Marshal(map[string]any{
+		"access_token":  accessToken,
+		"token_type":    "bearer",
+		"refresh_token": refreshToken,
+		"expires_in":    int64(expiresIn.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
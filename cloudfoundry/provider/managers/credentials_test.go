@@ -0,0 +1,128 @@
+package managers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeCredentials(t *testing.T, file, accessToken, refreshToken string) {
+	t.Helper()
+	content := fmt.Sprintf(`{"access_token":%q,"refresh_token":%q}`, accessToken, refreshToken)
+	assert.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+}
+
+func TestCredentialSource(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	t.Run("happy path - token file", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "tokens.json")
+		writeCredentials(t, tokenFile, " bearer access ", "refresh")
+
+		credentials, err := newCredentialSource(tokenFile, "").Credentials(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, credentialTokens{AccessToken: "bearer access", RefreshToken: "refresh"}, credentials)
+	})
+	t.Run("happy path - credential process", func(t *testing.T) {
+		credentials, err := newCredentialSource("", `echo '{"access_token": "access"}'`).Credentials(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, credentialTokens{AccessToken: "access"}, credentials)
+	})
+	t.Run("error path - failing credential process", func(t *testing.T) {
+		_, err := newCredentialSource("", "echo 'not logged in' >&2; exit 1").Credentials(ctx)
+		assert.ErrorContains(t, err, "not logged in")
+	})
+	t.Run("error path - invalid credentials", func(t *testing.T) {
+		_, err := newCredentialSource(filepath.Join(t.TempDir(), "missing.json"), "").Credentials(ctx)
+		assert.ErrorContains(t, err, "unable to read token_file")
+
+		_, err = newCredentialSource("", "echo access").Credentials(ctx)
+		assert.ErrorContains(t, err, "expected a JSON object")
+
+		_, err = newCredentialSource("", "echo '{}'").Credentials(ctx)
+		assert.ErrorContains(t, err, "neither access_token nor refresh_token is set")
+	})
+}
+
+// Runs go-cfclient against a fake API and UAA through the sequence of token changes of a secrets agent, as go-cfclient
+// only knows the tokens it was created with and asks UAA for new ones.
+func TestCloudFoundryProviderConfig_NewSessionWithTokenFile(t *testing.T) {
+	t.Parallel()
+	refreshed := newTestJWT("refreshed", time.Now().Add(10*time.Minute))
+	var mutex sync.Mutex
+	var accepted, authorization string
+	var refreshTokens []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			_, _ = fmt.Fprintf(w, `{"links":{"self":{"href":%[1]q},"login":{"href":%[1]q},"uaa":{"href":%[1]q}}}`, server.URL)
+		case "/oauth/token":
+			refreshTokens = append(refreshTokens, r.FormValue("refresh_token"))
+			if r.FormValue("refresh_token") != "rotated" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"access_token":%q,"token_type":"bearer","expires_in":600}`, refreshed)
+		default:
+			authorization = r.Header.Get("Authorization")
+			if authorization != "Bearer "+accepted {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = io.WriteString(w, `{"errors":[{"code":1000,"title":"CF-InvalidAuthToken","detail":"Invalid Auth Token"}]}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"pagination":{"total_results":0,"total_pages":1},"resources":[]}`)
+		}
+	}))
+	defer server.Close()
+	accept := func(accessToken string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		accepted, authorization, refreshTokens = accessToken, "", nil
+	}
+
+	// The source holds an expired access token only, go-cfclient is created with a placeholder refresh token.
+	tokenFile := filepath.Join(t.TempDir(), "tokens.json")
+	writeCredentials(t, tokenFile, newTestJWT("expired", time.Now().Add(-time.Minute)), "")
+	c := &CloudFoundryProviderConfig{Endpoint: server.URL, TokenFile: tokenFile, DisableLookupCache: true}
+	session, err := c.NewSession(nil, provider.ConfigureRequest{})
+	assert.NoError(t, err)
+
+	// The secrets agent writes a new access token before the first request, it is handed out without asking UAA.
+	current := newTestJWT("current", time.Now().Add(10*time.Minute))
+	writeCredentials(t, tokenFile, "bearer "+current, "")
+	accept(current)
+	_, err = session.CFClient.Organizations.ListAll(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer "+current, authorization)
+	assert.Empty(t, refreshTokens)
+
+	// The access token is revoked while the agent holds an expired access token and a rotated refresh token, which is
+	// sent to UAA instead of the placeholder.
+	writeCredentials(t, tokenFile, newTestJWT("expired", time.Now().Add(-time.Minute)), "rotated")
+	accept(refreshed)
+	_, err = session.CFClient.Organizations.ListAll(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer "+refreshed, authorization)
+	assert.Equal(t, []string{"rotated"}, refreshTokens)
+
+	// Without a valid access token or a refresh token in the source, the placeholder is never sent to UAA.
+	writeCredentials(t, tokenFile, newTestJWT("expired", time.Now().Add(-time.Minute)), "")
+	accept("revoked")
+	_, err = session.CFClient.Organizations.ListAll(context.Background(), nil)
+	assert.ErrorContains(t, err, "no refresh token is available")
+	assert.NotContains(t, refreshTokens, credentialSourceRefreshToken)
+}
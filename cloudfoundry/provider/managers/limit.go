@@ -0,0 +1,51 @@
+package managers
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// limitTransport caps the rate and the number of concurrent requests of one provider instance. As all clients of a
+// session, including the MTA client, are built on the same HTTP client, they share the limits.
+type limitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+func newLimitTransport(next http.RoundTripper, requestsPerSecond int, concurrentRequests int) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if requestsPerSecond <= 0 && concurrentRequests <= 0 {
+		return next
+	}
+	t := &limitTransport{next: next}
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+	}
+	if concurrentRequests > 0 {
+		t.slots = make(chan struct{}, concurrentRequests)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// The slot is freed once the response headers arrived, reading the body is left to the caller.
+		defer func() { <-t.slots }()
+	}
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return t.next.RoundTrip(req)
+}
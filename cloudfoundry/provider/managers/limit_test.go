@@ -0,0 +1,78 @@
+package managers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitTransport(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - no limits", func(t *testing.T) {
+		assert.Equal(t, http.DefaultTransport, newLimitTransport(nil, 0, 0))
+	})
+	t.Run("happy path - cap concurrent requests", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+		}))
+		defer server.Close()
+		client := &http.Client{Transport: newLimitTransport(nil, 0, 2)}
+
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				resp, err := client.Get(server.URL)
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			})
+		}
+		wg.Wait()
+		assert.Equal(t, int32(2), maxInFlight.Load())
+	})
+	t.Run("happy path - limit requests per second", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+		client := &http.Client{Transport: newLimitTransport(nil, 10, 0)}
+
+		start := time.Now()
+		for range 15 {
+			resp, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}
+		// The first 10 requests use the burst, the remaining 5 are spread over half a second.
+		assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	})
+	t.Run("error path - context cancelled while waiting", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+		client := &http.Client{Transport: newLimitTransport(nil, 1, 0)}
+
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err = client.Do(req)
+		assert.Error(t, err)
+	})
+}
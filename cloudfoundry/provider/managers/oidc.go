@@ -0,0 +1,151 @@
+package managers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	oidcRequestTimeout = 30 * time.Second
+)
+
+// OIDCConfig tells where to get the OIDC token of the CI platform, which is exchanged for a Cloud Foundry token with
+// the JWT bearer grant of UAA. The token is read from TokenFile, from the environment variable TokenEnv or requested
+// from RequestURL as in GitHub Actions, in this order.
+type OIDCConfig struct {
+	RequestURL   string
+	RequestToken string
+	Audience     string
+	TokenFile    string
+	TokenEnv     string
+}
+
+// oidcTokenSource hands out the OIDC token of the CI platform and gets a new one shortly before it expires.
+type oidcTokenSource struct {
+	config OIDCConfig
+	client *http.Client
+
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// Returns a token source which requests the token of the CI platform with the given client. Without a client, a plain
+// one is used, as the token service is not part of Cloud Foundry and none of the settings for the API apply to it.
+func newOIDCTokenSource(config OIDCConfig, client *http.Client) *oidcTokenSource {
+	if client == nil {
+		client = &http.Client{Timeout: oidcRequestTimeout}
+	}
+	return &oidcTokenSource{config: config, client: client}
+}
+
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" && time.Now().Add(time.Minute).Before(s.expiry) {
+		return s.token, nil
+	}
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the OIDC token: %w", err)
+	}
+	if token == "" {
+		return "", errors.New("unable to get the OIDC token: the token is empty")
+	}
+	s.token = token
+	s.expiry = jwtExpiry(token)
+	return token, nil
+}
+
+func (s *oidcTokenSource) fetch(ctx context.Context) (string, error) {
+	switch {
+	case s.config.TokenFile != "":
+		token, err := os.ReadFile(s.config.TokenFile)
+		return strings.TrimSpace(string(token)), err
+	case s.config.TokenEnv != "":
+		return strings.TrimSpace(os.Getenv(s.config.TokenEnv)), nil
+	case s.config.RequestURL != "":
+		return s.request(ctx)
+	default:
+		return "", errors.New("neither a token file, a token environment variable nor a request URL is configured")
+	}
+}
+
+// Requests the token from the token service of the CI platform, see
+// https://docs.github.com/en/actions/security-for-github-actions/security-hardening-your-deployments/about-security-hardening-with-openid-connect
+func (s *oidcTokenSource) request(ctx context.Context) (string, error) {
+	requestURL, err := url.Parse(s.config.RequestURL)
+	if err != nil {
+		return "", err
+	}
+	if s.config.Audience != "" {
+		query := requestURL.Query()
+		query.Set("audience", s.config.Audience)
+		requestURL.RawQuery = query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.RequestToken)
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request failed with status %s", resp.Status)
+	}
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	return body.Value, nil
+}
+
+// Returns the expiry of the JWT, or the zero time if it cannot be read, so that the token is not reused.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+// Returns a transport which presents a fresh OIDC token whenever go-cfclient exchanges its JWT bearer assertion at
+// UAA. The OIDC tokens of CI platforms are only valid for minutes, while go-cfclient keeps the assertion it was created
+// with and presents it again when it has to re-authenticate during a long apply.
+func newAssertionTransport(next http.RoundTripper, source *oidcTokenSource) http.RoundTripper {
+	return newTokenTransport(next, jwtBearerGrantType, func(req *http.Request, form url.Values) (*http.Response, error) {
+		assertion, err := source.Token(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		form.Set("assertion", assertion)
+		return nil, nil
+	})
+}
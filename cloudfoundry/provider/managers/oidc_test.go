@@ -0,0 +1,101 @@
+package managers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns an unsigned JWT with the given subject which expires at the given time.
+func newTestJWT(subject string, expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	payload := fmt.Sprintf(`{"sub":%q,"exp":%d}`, subject, expiry.Unix())
+	return encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(payload)) + ".signature"
+}
+
+func TestOIDCTokenSource(t *testing.T) {
+	ctx := context.Background()
+	t.Run("happy path - token file is read again on expiry", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		expired := newTestJWT("first", time.Now().Add(30*time.Second))
+		assert.NoError(t, os.WriteFile(tokenFile, []byte(expired+"\n"), 0o600))
+		source := newOIDCTokenSource(OIDCConfig{TokenFile: tokenFile}, nil)
+
+		token, err := source.Token(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, expired, token)
+
+		valid := newTestJWT("second", time.Now().Add(time.Hour))
+		assert.NoError(t, os.WriteFile(tokenFile, []byte(valid), 0o600))
+		token, err = source.Token(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, valid, token)
+
+		assert.NoError(t, os.WriteFile(tokenFile, []byte(newTestJWT("third", time.Now().Add(time.Hour))), 0o600))
+		token, err = source.Token(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, valid, token)
+	})
+	t.Run("happy path - token environment variable", func(t *testing.T) {
+		token := newTestJWT("env", time.Now().Add(time.Hour))
+		t.Setenv("TEST_CF_OIDC_TOKEN", token)
+		source := newOIDCTokenSource(OIDCConfig{TokenEnv: "TEST_CF_OIDC_TOKEN"}, nil)
+
+		value, err := source.Token(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, token, value)
+	})
+	t.Run("happy path - token request", func(t *testing.T) {
+		token := newTestJWT("github", time.Now().Add(time.Hour))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer request-token" || r.URL.Query().Get("audience") != "cloudfoundry" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"count":1,"value":%q}`, token)
+		}))
+		defer server.Close()
+		source := newOIDCTokenSource(OIDCConfig{
+			RequestURL:   server.URL + "/token?api-version=2.0",
+			RequestToken: "request-token",
+			Audience:     "cloudfoundry",
+		}, nil)
+
+		value, err := source.Token(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, token, value)
+	})
+	t.Run("error path - token request denied", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		source := newOIDCTokenSource(OIDCConfig{RequestURL: server.URL, RequestToken: "wrong"}, nil)
+
+		_, err := source.Token(ctx)
+		assert.ErrorContains(t, err, "403")
+	})
+	t.Run("error path - empty token", func(t *testing.T) {
+		source := newOIDCTokenSource(OIDCConfig{TokenEnv: "TEST_CF_OIDC_TOKEN_UNSET"}, nil)
+
+		_, err := source.Token(ctx)
+		assert.ErrorContains(t, err, "the token is empty")
+	})
+}
+
+func TestJWTExpiry(t *testing.T) {
+	t.Parallel()
+	expiry := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+
+	assert.Equal(t, expiry, jwtExpiry(newTestJWT("user", expiry)))
+	assert.True(t, jwtExpiry("not-a-jwt").IsZero())
+	assert.True(t, jwtExpiry("a.b.c").IsZero())
+}
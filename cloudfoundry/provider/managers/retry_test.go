@@ -0,0 +1,122 @@
+package managers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a server which answers the first failures requests with the given status and all further requests with 200.
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if requests.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - retry get on service unavailable", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), requests.Load())
+	})
+	t.Run("happy path - retry put with body", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusBadGateway, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond)}
+
+		req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"org"}`))
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `{"name":"org"}`, string(body))
+		assert.Equal(t, int32(2), requests.Load())
+	})
+	t.Run("happy path - no retry of post", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+	t.Run("happy path - no retry of client errors", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusNotFound, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+	t.Run("error path - retries exhausted", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 5, http.StatusGatewayTimeout, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 2, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+		assert.Equal(t, int32(3), requests.Load())
+	})
+	t.Run("happy path - retries disabled", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+		client := &http.Client{Transport: newRetryTransport(nil, 0, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+	t.Run("happy path - honour retry after", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+		client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 5*time.Second)}
+
+		start := time.Now()
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(2), requests.Load())
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	t.Parallel()
+	transport := &retryTransport{minWait: time.Second, maxWait: 5 * time.Second}
+
+	assert.Equal(t, time.Second, transport.backoff(0, nil))
+	assert.Equal(t, 4*time.Second, transport.backoff(2, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(70, nil))
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	assert.Equal(t, 2*time.Second, transport.backoff(0, resp))
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, 5*time.Second, transport.backoff(0, resp))
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), transport.backoff(0, resp))
+	resp.Header.Set("Retry-After", "soon")
+	assert.Equal(t, time.Second, transport.backoff(0, resp))
+}
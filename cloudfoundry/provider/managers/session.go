@@ -1,9 +1,16 @@
 package managers
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -11,23 +18,40 @@ import (
 	config "github.com/cloudfoundry/go-cfclient/v3/config"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/version"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"golang.org/x/oauth2"
 )
 
 type CloudFoundryProviderConfig struct {
-	Endpoint          string
-	User              string
-	Password          string
-	CFClientID        string
-	CFClientSecret    string
-	SkipSslValidation bool
-	Origin            string
-	AccessToken       string
-	RefreshToken      string
-	AssertionToken    string
+	Endpoint              string
+	User                  string
+	Password              string
+	CFClientID            string
+	CFClientSecret        string
+	SkipSslValidation     bool
+	Origin                string
+	AccessToken           string
+	RefreshToken          string
+	AssertionToken        string
+	MaxRetries            int
+	RetryMinWait          time.Duration
+	RetryMaxWait          time.Duration
+	MaxRequestsPerSecond  int
+	MaxConcurrentRequests int
+	DisableLookupCache    bool
+	CACert                string
+	CACertFile            string
+	ClientCert            string
+	ClientKey             string
+	ProxyURL              string
+	OIDC                  *OIDCConfig
+	TokenFile             string
+	CredentialProcess     string
 }
 
 type Session struct {
 	CFClient *client.Client
+	// Lookups caches name lookups of the session, it is nil if the cache is disabled.
+	Lookups *LookupCache
 }
 
 func (c *CloudFoundryProviderConfig) NewSession(httpClient *http.Client, req provider.ConfigureRequest) (*Session, error) {
@@ -47,9 +71,25 @@ func (c *CloudFoundryProviderConfig) NewSession(httpClient *http.Client, req pro
 	}
 	opts = append(opts, config.UserAgent(finalAgent))
 
-	if httpClient != nil {
-		opts = append(opts, config.HttpClient(httpClient))
+	var lookups *LookupCache
+	if !c.DisableLookupCache {
+		lookups = NewLookupCache(DefaultLookupCacheTTL)
+	}
+	cfHTTPClient, err := c.newHTTPClient(httpClient, lookups)
+	if err != nil {
+		return nil, err
+	}
+	var oidcSource *oidcTokenSource
+	if c.OIDC != nil {
+		oidcSource = newOIDCTokenSource(*c.OIDC, nil)
+		cfHTTPClient.Transport = newAssertionTransport(cfHTTPClient.Transport, oidcSource)
+	}
+	var externalSource *credentialSource
+	if c.TokenFile != "" || c.CredentialProcess != "" {
+		externalSource = newCredentialSource(c.TokenFile, c.CredentialProcess)
+		cfHTTPClient.Transport = newCredentialTransport(cfHTTPClient.Transport, externalSource)
 	}
+	opts = append(opts, config.HttpClient(cfHTTPClient))
 	if c.SkipSslValidation {
 		opts = append(opts, config.SkipTLSValidation())
 	}
@@ -57,6 +97,23 @@ func (c *CloudFoundryProviderConfig) NewSession(httpClient *http.Client, req pro
 		opts = append(opts, config.Origin(c.Origin))
 	}
 	switch {
+	case oidcSource != nil:
+		var assertion string
+		if assertion, err = oidcSource.Token(context.Background()); err != nil {
+			return nil, err
+		}
+		opts = append(opts, config.JWTBearerAssertion(assertion))
+		cfg, err = config.New(c.Endpoint, opts...)
+	case externalSource != nil:
+		var credentials credentialTokens
+		if credentials, err = externalSource.Credentials(context.Background()); err != nil {
+			return nil, err
+		}
+		if credentials.RefreshToken == "" {
+			credentials.RefreshToken = credentialSourceRefreshToken
+		}
+		opts = append(opts, config.Token(credentials.AccessToken, credentials.RefreshToken))
+		cfg, err = config.New(c.Endpoint, opts...)
 	case c.User != "" && c.Password != "":
 		opts = append(opts, config.UserPassword(c.User, c.Password))
 		cfg, err = config.New(c.Endpoint, opts...)
@@ -81,6 +138,152 @@ func (c *CloudFoundryProviderConfig) NewSession(httpClient *http.Client, req pro
 	}
 	s := Session{
 		CFClient: cf,
+		Lookups:  lookups,
 	}
 	return &s, nil
 }
+
+// Returns a copy of the given HTTP client whose transport retries idempotent requests which failed with a transient
+// error and keeps to the configured request limits. Writes purge the given lookup cache. TLS and the proxy are
+// configured here as go-cfclient only configures plain HTTP transports. All clients of the session, i.e. for the API,
+// UAA and the MTA deployer, are built on this client.
+func (c *CloudFoundryProviderConfig) newHTTPClient(httpClient *http.Client, lookups *LookupCache) (*http.Client, error) {
+	client := &http.Client{}
+	if httpClient != nil {
+		*client = *httpClient
+	}
+
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		httpTransport = httpTransport.Clone()
+		if httpTransport.TLSClientConfig == nil {
+			httpTransport.TLSClientConfig = &tls.Config{}
+		}
+		httpTransport.TLSClientConfig.InsecureSkipVerify = c.SkipSslValidation || (c.Endpoint == "" && cfHomeSSLDisabled())
+		if err := c.configureTLS(httpTransport.TLSClientConfig); err != nil {
+			return nil, err
+		}
+		if c.ProxyURL != "" {
+			proxyURL, err := url.Parse(c.ProxyURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy_url: %w", err)
+			}
+			httpTransport.Proxy = http.ProxyURL(proxyURL)
+		}
+		transport = httpTransport
+	}
+	transport = newLimitTransport(transport, c.MaxRequestsPerSecond, c.MaxConcurrentRequests)
+	transport = newRetryTransport(transport, c.MaxRetries, c.RetryMinWait, c.RetryMaxWait)
+	transport = newPurgeTransport(transport, lookups)
+	client.Transport = &authorizationProbeTransport{next: transport}
+	return client, nil
+}
+
+// Trusts the configured CA certificates in addition to the system ones and presents the configured client certificate
+// for mutual TLS.
+func (c *CloudFoundryProviderConfig) configureTLS(tlsConfig *tls.Config) error {
+	caCert := []byte(c.CACert)
+	if c.CACertFile != "" {
+		var err error
+		if caCert, err = os.ReadFile(c.CACertFile); err != nil {
+			return fmt.Errorf("unable to read ca_cert_file: %w", err)
+		}
+	}
+	if len(caCert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return errors.New("no PEM encoded certificate found in the CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.ClientCert != "" || c.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return nil
+}
+
+// Returns whether the CF CLI was targeted with --skip-ssl-validation, which go-cfclient would otherwise pick up from
+// the CF home directory.
+func cfHomeSSLDisabled() bool {
+	cfHome := os.Getenv("CF_HOME")
+	if cfHome == "" {
+		var err error
+		if cfHome, err = os.UserHomeDir(); err != nil {
+			return false
+		}
+	}
+	raw, err := os.ReadFile(filepath.Join(cfHome, ".cf", "config.json"))
+	if err != nil {
+		return false
+	}
+	var cfConfig struct {
+		SSLDisabled bool
+	}
+	if err = json.Unmarshal(raw, &cfConfig); err != nil {
+		return false
+	}
+	return cfConfig.SSLDisabled
+}
+
+// Token returns the access token which authorizes the requests of the session. It is taken from the token source of
+// the authenticated client, which refreshes it once it expired, so that no additional login happens.
+func (s *Session) Token(ctx context.Context) (*oauth2.Token, error) {
+	var authorization string
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, authorizationProbeKey{}, &authorization), http.MethodGet, s.CFClient.ApiURL("/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.CFClient.HTTPAuthClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	tokenType, accessToken, found := strings.Cut(authorization, " ")
+	if !found {
+		return nil, errors.New("the session did not authorize the request with an access token")
+	}
+	return &oauth2.Token{
+		AccessToken: accessToken,
+		TokenType:   tokenType,
+		Expiry:      jwtExpiry(accessToken),
+	}, nil
+}
+
+// authorizationProbeKey marks a request of Session.Token in its context, the value is set to the authorization header
+// of the request.
+type authorizationProbeKey struct{}
+
+// authorizationProbeTransport answers the requests of Session.Token without sending them, after the authenticated
+// client added the authorization header.
+type authorizationProbeTransport struct {
+	next http.RoundTripper
+}
+
+func (t *authorizationProbeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	authorization, ok := req.Context().Value(authorizationProbeKey{}).(*string)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	*authorization = req.Header.Get("Authorization")
+	return &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
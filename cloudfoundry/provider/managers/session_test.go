@@ -0,0 +1,176 @@
+package managers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a PEM encoded self-signed client certificate and its key.
+func newClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func serverCACert(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func TestCloudFoundryProviderConfig_NewHTTPClient(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	t.Run("error path - untrusted server certificate", func(t *testing.T) {
+		c := &CloudFoundryProviderConfig{Endpoint: server.URL}
+		client, err := c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+
+		_, err = client.Get(server.URL)
+		assert.ErrorContains(t, err, "certificate")
+	})
+	t.Run("happy path - ca certificate", func(t *testing.T) {
+		c := &CloudFoundryProviderConfig{Endpoint: server.URL, CACert: serverCACert(server)}
+		client, err := c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+
+		resp, err := client.Get(server.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	})
+	t.Run("happy path - ca certificate file", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		assert.NoError(t, os.WriteFile(caFile, []byte(serverCACert(server)), 0o600))
+		c := &CloudFoundryProviderConfig{Endpoint: server.URL, CACertFile: caFile}
+		client, err := c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+
+		resp, err := client.Get(server.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	})
+	t.Run("error path - invalid ca certificate", func(t *testing.T) {
+		c := &CloudFoundryProviderConfig{Endpoint: server.URL, CACert: "not a certificate"}
+		_, err := c.newHTTPClient(nil, nil)
+		assert.ErrorContains(t, err, "no PEM encoded certificate")
+
+		c = &CloudFoundryProviderConfig{Endpoint: server.URL, CACertFile: filepath.Join(t.TempDir(), "missing.pem")}
+		_, err = c.newHTTPClient(nil, nil)
+		assert.ErrorContains(t, err, "unable to read ca_cert_file")
+	})
+	t.Run("happy path - client certificate", func(t *testing.T) {
+		clientCert, clientKey := newClientCertificate(t)
+		clientCAs := x509.NewCertPool()
+		clientCAs.AppendCertsFromPEM([]byte(clientCert))
+		mtlsServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		mtlsServer.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+		mtlsServer.StartTLS()
+		defer mtlsServer.Close()
+
+		c := &CloudFoundryProviderConfig{Endpoint: mtlsServer.URL, CACert: serverCACert(mtlsServer)}
+		client, err := c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+		_, err = client.Get(mtlsServer.URL)
+		assert.Error(t, err)
+
+		c.ClientCert, c.ClientKey = clientCert, clientKey
+		client, err = c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+		resp, err := client.Get(mtlsServer.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	})
+	t.Run("error path - invalid client certificate", func(t *testing.T) {
+		clientCert, _ := newClientCertificate(t)
+		_, otherKey := newClientCertificate(t)
+		c := &CloudFoundryProviderConfig{Endpoint: server.URL, ClientCert: clientCert, ClientKey: otherKey}
+		_, err := c.newHTTPClient(nil, nil)
+		assert.ErrorContains(t, err, "invalid client certificate")
+	})
+	t.Run("happy path - proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+		}))
+		defer proxy.Close()
+
+		c := &CloudFoundryProviderConfig{Endpoint: "http://api.example.com", ProxyURL: proxy.URL}
+		client, err := c.newHTTPClient(nil, nil)
+		assert.NoError(t, err)
+		resp, err := client.Get("http://api.example.com/v3/info")
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+		assert.Equal(t, "http://api.example.com/v3/info", proxied)
+	})
+}
+
+func TestSession_Token(t *testing.T) {
+	t.Parallel()
+	accessToken := newTestJWT("user", time.Now().Add(10*time.Minute))
+	var logins int
+	var authorization string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			_, _ = fmt.Fprintf(w, `{"links":{"self":{"href":%[1]q},"login":{"href":%[1]q},"uaa":{"href":%[1]q}}}`, server.URL)
+		case "/oauth/token":
+			logins++
+			_, _ = fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"refresh","token_type":"bearer","expires_in":600}`, accessToken)
+		default:
+			authorization = r.Header.Get("Authorization")
+			_, _ = io.WriteString(w, `{"pagination":{"total_results":0,"total_pages":1},"resources":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	c := &CloudFoundryProviderConfig{Endpoint: server.URL, User: "user", Password: "password", DisableLookupCache: true}
+	session, err := c.NewSession(nil, provider.ConfigureRequest{})
+	assert.NoError(t, err)
+
+	token, err := session.Token(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, accessToken, token.AccessToken)
+	assert.Equal(t, jwtExpiry(accessToken), token.Expiry)
+
+	_, err = session.CFClient.Organizations.ListAll(t.Context(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer "+accessToken, authorization)
+	assert.Equal(t, 1, logins, "the token of the session is handed out without logging in again")
+}
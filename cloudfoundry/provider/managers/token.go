@@ -0,0 +1,60 @@
+package managers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// tokenRewriteFunc changes the form of a token request of go-cfclient before it is sent to UAA. If it returns a
+// response, the request is answered with it instead of being sent.
+type tokenRewriteFunc func(req *http.Request, form url.Values) (*http.Response, error)
+
+// tokenTransport rewrites the requests of go-cfclient to the token endpoint of UAA with the given grant type, e.g. to
+// present credentials which changed since go-cfclient was created. All other requests are passed through unchanged.
+type tokenTransport struct {
+	next      http.RoundTripper
+	grantType string
+	rewrite   tokenRewriteFunc
+}
+
+func newTokenTransport(next http.RoundTripper, grantType string, rewrite tokenRewriteFunc) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &tokenTransport{next: next, grantType: grantType, rewrite: rewrite}
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, "/oauth/token") || req.Body == nil || req.Body == http.NoBody {
+		return t.next.RoundTrip(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	form, err := url.ParseQuery(string(body))
+	if err != nil || form.Get("grant_type") != t.grantType {
+		setRequestBody(req, body)
+		return t.next.RoundTrip(req)
+	}
+
+	resp, err := t.rewrite(req, form)
+	if err != nil || resp != nil {
+		return resp, err
+	}
+	setRequestBody(req, []byte(form.Encode()))
+	return t.next.RoundTrip(req)
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+}
@@ -0,0 +1,90 @@
+package managers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Covers the OIDC assertion and the credential source rewriting the token requests of go-cfclient, stacked on the
+// same transport as in a session.
+func TestTokenTransport(t *testing.T) {
+	t.Parallel()
+	var received url.Values
+	var receivedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received, _ = url.ParseQuery(string(body))
+		receivedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"access_token":"from-uaa","token_type":"bearer","expires_in":600}`)
+	}))
+	defer server.Close()
+
+	assertionFile := filepath.Join(t.TempDir(), "assertion")
+	fresh := newTestJWT("fresh", time.Now().Add(time.Hour))
+	assert.NoError(t, os.WriteFile(assertionFile, []byte(fresh), 0o600))
+	tokenFile := filepath.Join(t.TempDir(), "tokens.json")
+	transport := newAssertionTransport(nil, newOIDCTokenSource(OIDCConfig{TokenFile: assertionFile}, nil))
+	client := &http.Client{Transport: newCredentialTransport(transport, newCredentialSource(tokenFile, ""))}
+	post := func(path string, form url.Values) (string, error) {
+		received, receivedPath = nil, ""
+		resp, err := client.Post(server.URL+path, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	t.Run("happy path - fresh OIDC token is presented as assertion", func(t *testing.T) {
+		_, err := post("/oauth/token", url.Values{"grant_type": {jwtBearerGrantType}, "assertion": {"stale"}, "client_id": {"cf"}})
+		assert.NoError(t, err)
+		assert.Equal(t, fresh, received.Get("assertion"))
+		assert.Equal(t, "cf", received.Get("client_id"))
+	})
+	t.Run("happy path - valid access token of the credential source is handed out without asking UAA", func(t *testing.T) {
+		accessToken := newTestJWT("user", time.Now().Add(time.Hour))
+		writeCredentials(t, tokenFile, "bearer "+accessToken, "")
+
+		body, err := post("/oauth/token", url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"stale"}})
+		assert.NoError(t, err)
+		assert.Nil(t, received)
+		assert.Contains(t, body, fmt.Sprintf(`"access_token":%q`, accessToken))
+	})
+	t.Run("happy path - expired access token is refreshed with the refresh token of the credential source", func(t *testing.T) {
+		writeCredentials(t, tokenFile, newTestJWT("user", time.Now().Add(-time.Minute)), "rotated")
+
+		_, err := post("/oauth/token", url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"stale"}})
+		assert.NoError(t, err)
+		assert.Equal(t, "rotated", received.Get("refresh_token"))
+	})
+	t.Run("error path - expired access token without refresh token", func(t *testing.T) {
+		writeCredentials(t, tokenFile, newTestJWT("user", time.Now().Add(-time.Minute)), "")
+
+		_, err := post("/oauth/token", url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"stale"}})
+		assert.ErrorContains(t, err, "no refresh token is available")
+	})
+	t.Run("happy path - other grants and requests are passed through", func(t *testing.T) {
+		form := url.Values{"grant_type": {"password"}, "username": {"user"}}
+		_, err := post("/oauth/token", form)
+		assert.NoError(t, err)
+		assert.Equal(t, form, received)
+
+		form = url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"stale"}}
+		_, err = post("/v3/service_instances", form)
+		assert.NoError(t, err)
+		assert.Equal(t, "/v3/service_instances", receivedPath)
+		assert.Equal(t, form, received)
+	})
+}
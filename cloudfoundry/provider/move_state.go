@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The community provider is published as cloudfoundry-community/cloudfoundry, possibly from a mirror or a private registry.
+const communityProviderAddressSuffix = "/cloudfoundry-community/cloudfoundry"
+
+// Attributes of the community provider resources which are needed to move them to this provider.
+// Everything else is read from the platform on the refresh following the move, just like after an import.
+type communityResourceState struct {
+	ID string `json:"id"`
+}
+
+type communityAppState struct {
+	Path              string            `json:"path"`
+	SourceCodeHash    string            `json:"source_code_hash"`
+	DockerImage       string            `json:"docker_image"`
+	DockerCredentials map[string]string `json:"docker_credentials"`
+}
+
+type communityServiceInstanceState struct {
+	JSONParams string `json:"json_params"`
+}
+
+type communityUserProvidedServiceState struct {
+	Credentials     map[string]any `json:"credentials"`
+	CredentialsJSON string         `json:"credentials_json"`
+}
+
+type communityServiceKeyState struct {
+	Params     map[string]any `json:"params"`
+	ParamsJSON string         `json:"params_json"`
+}
+
+type communityOrgUsersState struct {
+	Org             string   `json:"org"`
+	Managers        []string `json:"managers"`
+	BillingManagers []string `json:"billing_managers"`
+	Auditors        []string `json:"auditors"`
+}
+
+type communitySpaceUsersState struct {
+	Space      string   `json:"space"`
+	Managers   []string `json:"managers"`
+	Developers []string `json:"developers"`
+	Auditors   []string `json:"auditors"`
+	Supporters []string `json:"supporters"`
+}
+
+// Returns a state mover for a resource of the community provider which is identified by the same GUID in this provider.
+// mapAttributes can be used to carry over attributes which cannot be read from the platform, e.g. sensitive values.
+func communityStateMover(sourceTypeName string, mapAttributes func(ctx context.Context, rawState []byte, targetState *tfsdk.State) diag.Diagnostics) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			rawState, diags := getCommunityRawState(req, sourceTypeName)
+			resp.Diagnostics.Append(diags...)
+			if rawState == nil || resp.Diagnostics.HasError() {
+				return
+			}
+
+			var source communityResourceState
+			resp.Diagnostics.Append(decodeCommunityState(rawState, sourceTypeName, &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if source.ID == "" {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					"The state of "+sourceTypeName+" does not contain an id.",
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(idKey), source.ID)...)
+			if mapAttributes != nil {
+				resp.Diagnostics.Append(mapAttributes(ctx, rawState, &resp.TargetState)...)
+			}
+		},
+	}
+}
+
+// Returns the raw JSON state if the state to move belongs to the given resource type of the community provider,
+// nil if the state mover is not responsible for it.
+func getCommunityRawState(req resource.MoveStateRequest, sourceTypeName string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !strings.HasSuffix(req.SourceProviderAddress, communityProviderAddressSuffix) || req.SourceTypeName != sourceTypeName {
+		return nil, diags
+	}
+	if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			"The state of "+sourceTypeName+" is in a legacy format, please refresh it with the community provider before moving it.",
+		)
+		return nil, diags
+	}
+	return req.SourceRawState.JSON, diags
+}
+
+func decodeCommunityState(rawState []byte, sourceTypeName string, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := json.Unmarshal(rawState, target); err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			"The state of "+sourceTypeName+" could not be decoded: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// Converts a JSON string or a map of the community provider state to a normalized JSON value, which is null if neither is set.
+func communityJSONValue(jsonValue string, mapValue map[string]any) (jsontypes.Normalized, error) {
+	if jsonValue != "" {
+		return jsontypes.NewNormalizedValue(jsonValue), nil
+	}
+	if len(mapValue) == 0 {
+		return jsontypes.NewNormalizedNull(), nil
+	}
+	bytes, err := json.Marshal(mapValue)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+	return jsontypes.NewNormalizedValue(string(bytes)), nil
+}
+
+// Maps the app attributes which the platform does not return.
+func mapCommunityAppState(ctx context.Context, rawState []byte, targetState *tfsdk.State) diag.Diagnostics {
+	var source communityAppState
+	diags := decodeCommunityState(rawState, "cloudfoundry_app", &source)
+	if diags.HasError() {
+		return diags
+	}
+	if source.Path != "" {
+		diags.Append(targetState.SetAttribute(ctx, path.Root("path"), source.Path)...)
+	}
+	if source.SourceCodeHash != "" {
+		diags.Append(targetState.SetAttribute(ctx, path.Root("source_code_hash"), source.SourceCodeHash)...)
+	}
+	if source.DockerImage != "" && source.DockerCredentials["username"] != "" {
+		diags.Append(targetState.SetAttribute(ctx, path.Root("docker_credentials").AtName("username"), source.DockerCredentials["username"])...)
+		diags.Append(targetState.SetAttribute(ctx, path.Root("docker_credentials").AtName("password"), source.DockerCredentials["password"])...)
+	}
+	return diags
+}
+
+// Maps the parameters of a managed service instance, which the platform does not return for all brokers.
+func mapCommunityServiceInstanceState(ctx context.Context, rawState []byte, targetState *tfsdk.State) diag.Diagnostics {
+	var source communityServiceInstanceState
+	diags := decodeCommunityState(rawState, "cloudfoundry_service_instance", &source)
+	if diags.HasError() {
+		return diags
+	}
+	parameters, _ := communityJSONValue(source.JSONParams, nil)
+	diags.Append(targetState.SetAttribute(ctx, path.Root("type"), managedSerivceInstance)...)
+	diags.Append(targetState.SetAttribute(ctx, path.Root("parameters"), parameters)...)
+	return diags
+}
+
+// Maps the credentials of a user-provided service instance, which are sensitive and not returned with the instance.
+func mapCommunityUserProvidedServiceState(ctx context.Context, rawState []byte, targetState *tfsdk.State) diag.Diagnostics {
+	var source communityUserProvidedServiceState
+	diags := decodeCommunityState(rawState, "cloudfoundry_user_provided_service", &source)
+	if diags.HasError() {
+		return diags
+	}
+	credentials, err := communityJSONValue(source.CredentialsJSON, source.Credentials)
+	if err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			"The credentials of cloudfoundry_user_provided_service could not be converted: "+err.Error(),
+		)
+		return diags
+	}
+	diags.Append(targetState.SetAttribute(ctx, path.Root("type"), userProvidedServiceInstance)...)
+	diags.Append(targetState.SetAttribute(ctx, path.Root("credentials"), credentials)...)
+	return diags
+}
+
+// Maps the parameters of a service key, which the platform does not return.
+func mapCommunityServiceKeyState(ctx context.Context, rawState []byte, targetState *tfsdk.State) diag.Diagnostics {
+	var source communityServiceKeyState
+	diags := decodeCommunityState(rawState, "cloudfoundry_service_key", &source)
+	if diags.HasError() {
+		return diags
+	}
+	parameters, err := communityJSONValue(source.ParamsJSON, source.Params)
+	if err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			"The parameters of cloudfoundry_service_key could not be converted: "+err.Error(),
+		)
+		return diags
+	}
+	diags.Append(targetState.SetAttribute(ctx, path.Root("type"), keyServiceCredentialBinding)...)
+	diags.Append(targetState.SetAttribute(ctx, path.Root("parameters"), parameters)...)
+	return diags
+}
+
+// The community provider manages all users of a role type in a single resource, whereas this provider manages a single
+// role per resource. Only resources assigning exactly one role can be moved, the role GUID is looked up on the following
+// refresh as the platform is not available while moving state.
+func getSingleCommunityRole(sourceTypeName string, rolesByType map[string][]string) (string, string, diag.Diagnostics) {
+	var (
+		diags    diag.Diagnostics
+		assigned []string
+	)
+	for roleType, users := range rolesByType {
+		for _, user := range users {
+			assigned = append(assigned, roleType+" "+user)
+		}
+	}
+	if len(assigned) != 1 {
+		sort.Strings(assigned)
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("Only %s resources assigning exactly one role to one user can be moved, found %d role assignments: %s. Please split the resource into one resource per role or use import blocks instead.", sourceTypeName, len(assigned), strings.Join(assigned, ", ")),
+		)
+		return "", "", diags
+	}
+	roleType, user, _ := strings.Cut(assigned[0], " ")
+	return roleType, user, diags
+}
+
+// Sets the user of a moved role, which the community provider accepts as GUID or username.
+func setCommunityRoleUser(ctx context.Context, user string, targetState *tfsdk.State) diag.Diagnostics {
+	if validation.UuidRegexp.MatchString(user) {
+		return targetState.SetAttribute(ctx, path.Root("user"), user)
+	}
+	return targetState.SetAttribute(ctx, path.Root("username"), user)
+}
+
+// Looks up the role of a resource moved from the community provider, which is identified by its type, user and
+// org or space until its GUID is known.
+func findMovedRole(ctx context.Context, cfClient *cfv3client.Client, opts *cfv3client.RoleListOptions, roleType string, user types.String, username types.String, origin types.String) (*cfv3resource.Role, error) {
+	opts.Types = cfv3client.Filter{Values: []string{roleType}}
+	if user.IsNull() {
+		userOpts := cfv3client.NewUserListOptions()
+		userOpts.UserNames = cfv3client.Filter{Values: []string{username.ValueString()}}
+		if !origin.IsNull() {
+			userOpts.Origins = cfv3client.Filter{Values: []string{origin.ValueString()}}
+		}
+		cfUser, err := cfClient.Users.Single(ctx, userOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find user %s: %w", username.ValueString(), err)
+		}
+		user = types.StringValue(cfUser.GUID)
+	}
+	opts.UserGUIDs = cfv3client.Filter{Values: []string{user.ValueString()}}
+	return cfClient.Roles.Single(ctx, opts)
+}
+
+// Returns a state mover for cloudfoundry_org_users of the community provider assigning a single org role.
+func communityOrgUsersStateMover() resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			rawState, diags := getCommunityRawState(req, "cloudfoundry_org_users")
+			resp.Diagnostics.Append(diags...)
+			if rawState == nil || resp.Diagnostics.HasError() {
+				return
+			}
+
+			var source communityOrgUsersState
+			resp.Diagnostics.Append(decodeCommunityState(rawState, "cloudfoundry_org_users", &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			roleType, user, diags := getSingleCommunityRole("cloudfoundry_org_users", map[string][]string{
+				"organization_manager":         source.Managers,
+				"organization_billing_manager": source.BillingManagers,
+				"organization_auditor":         source.Auditors,
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("org"), source.Org)...)
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("type"), roleType)...)
+			resp.Diagnostics.Append(setCommunityRoleUser(ctx, user, &resp.TargetState)...)
+		},
+	}
+}
+
+// Returns a state mover for cloudfoundry_space_users of the community provider assigning a single space role.
+func communitySpaceUsersStateMover() resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			rawState, diags := getCommunityRawState(req, "cloudfoundry_space_users")
+			resp.Diagnostics.Append(diags...)
+			if rawState == nil || resp.Diagnostics.HasError() {
+				return
+			}
+
+			var source communitySpaceUsersState
+			resp.Diagnostics.Append(decodeCommunityState(rawState, "cloudfoundry_space_users", &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			roleType, user, diags := getSingleCommunityRole("cloudfoundry_space_users", map[string][]string{
+				"space_manager":   source.Managers,
+				"space_developer": source.Developers,
+				"space_auditor":   source.Auditors,
+				"space_supporter": source.Supporters,
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("space"), source.Space)...)
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("type"), roleType)...)
+			resp.Diagnostics.Append(setCommunityRoleUser(ctx, user, &resp.TargetState)...)
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCommunityProviderAddress = "registry.terraform.io/cloudfoundry-community/cloudfoundry"
+
+// Calls the state movers of the resource in order like the framework does and returns the moved state,
+// which is null if no state mover was responsible for the source.
+func moveCommunityState(t *testing.T, r resource.ResourceWithMoveState, providerAddress string, sourceTypeName string, rawState string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: providerAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+	for _, mover := range r.MoveState(ctx) {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			return resp.TargetState, resp.Diagnostics
+		}
+	}
+	return tfsdk.State{Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}, nil
+}
+
+func getMovedAttribute(t *testing.T, state tfsdk.State, attributePath path.Path) string {
+	t.Helper()
+	var value types.String
+	diags := state.GetAttribute(context.Background(), attributePath, &value)
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	return value.ValueString()
+}
+
+func getMovedJSONAttribute(t *testing.T, state tfsdk.State, attributePath path.Path) string {
+	t.Helper()
+	var value jsontypes.Normalized
+	diags := state.GetAttribute(context.Background(), attributePath, &value)
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	return value.ValueString()
+}
+
+func TestMoveState_Org(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - move org", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &orgResource{}, testCommunityProviderAddress, "cloudfoundry_org",
+			`{"id":"`+testOrgGUID+`","name":"tf-test","quota":"dd457c79-f7c9-4828-862b-35843d3b646d","delete_recursive_allowed":true}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, testOrgGUID, getMovedAttribute(t, state, path.Root("id")))
+		assert.Equal(t, "", getMovedAttribute(t, state, path.Root("name")))
+	})
+	t.Run("happy path - ignore other providers", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &orgResource{}, "registry.terraform.io/hashicorp/cloudfoundry", "cloudfoundry_org",
+			`{"id":"`+testOrgGUID+`"}`)
+		assert.False(t, diags.HasError())
+		assert.True(t, state.Raw.IsNull())
+	})
+	t.Run("error path - legacy state", func(t *testing.T) {
+		ctx := context.Background()
+		resp := &resource.MoveStateResponse{}
+		(&orgResource{}).MoveState(ctx)[0].StateMover(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: testCommunityProviderAddress,
+			SourceTypeName:        "cloudfoundry_org",
+			SourceRawState:        &tfprotov6.RawState{Flatmap: map[string]string{"id": testOrgGUID}},
+		}, resp)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestMoveState_Space(t *testing.T) {
+	t.Parallel()
+	state, diags := moveCommunityState(t, &SpaceResource{}, testCommunityProviderAddress, "cloudfoundry_space",
+		`{"id":"`+testSpaceGUID+`","name":"space","org":"`+testOrgGUID+`","asgs":["ba10cc63-cc43-46b1-a00c-5f2a0d7d992e"],"allow_ssh":true}`)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, testSpaceGUID, getMovedAttribute(t, state, path.Root("id")))
+}
+
+func TestMoveState_Route(t *testing.T) {
+	t.Parallel()
+	state, diags := moveCommunityState(t, &RouteResource{}, testCommunityProviderAddress, "cloudfoundry_route",
+		`{"id":"d0348ed0-6e89-4836-80db-16479526a748","hostname":"myapp","domain":"`+testDomainRouteGUID+`","space":"`+testSpaceRouteGUID+`","target":[{"app":"24a711f2-148b-4d48-b37a-90a66d6e842f","port":0}]}`)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "d0348ed0-6e89-4836-80db-16479526a748", getMovedAttribute(t, state, path.Root("id")))
+}
+
+func TestMoveState_App(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - move buildpack app", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &appResource{}, testCommunityProviderAddress, "cloudfoundry_app",
+			`{"id":"d0348ed0-6e89-4836-80db-16479526a748","name":"my-app","space":"`+testSpaceGUID+`","path":"/path/to/app.zip","source_code_hash":"abc","memory":512,"docker_credentials":null}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "d0348ed0-6e89-4836-80db-16479526a748", getMovedAttribute(t, state, path.Root("id")))
+		assert.Equal(t, "/path/to/app.zip", getMovedAttribute(t, state, path.Root("path")))
+		assert.Equal(t, "abc", getMovedAttribute(t, state, path.Root("source_code_hash")))
+	})
+	t.Run("happy path - move docker app with credentials", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &appResource{}, testCommunityProviderAddress, "cloudfoundry_app",
+			`{"id":"d0348ed0-6e89-4836-80db-16479526a748","name":"my-app","docker_image":"registry/app:1","docker_credentials":{"username":"user","password":"secret"}}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "user", getMovedAttribute(t, state, path.Root("docker_credentials").AtName("username")))
+		assert.Equal(t, "secret", getMovedAttribute(t, state, path.Root("docker_credentials").AtName("password")))
+	})
+}
+
+func TestMoveState_ServiceInstance(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - move managed service instance", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &serviceInstanceResource{}, testCommunityProviderAddress, "cloudfoundry_service_instance",
+			`{"id":"68fea1b6-11b9-4737-ad79-74e49832533f","name":"pricing-grid","json_params":"{\"plan\":\"small\"}"}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "68fea1b6-11b9-4737-ad79-74e49832533f", getMovedAttribute(t, state, path.Root("id")))
+		assert.Equal(t, managedSerivceInstance, getMovedAttribute(t, state, path.Root("type")))
+		assert.Equal(t, `{"plan":"small"}`, getMovedJSONAttribute(t, state, path.Root("parameters")))
+	})
+	t.Run("happy path - move user-provided service", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &serviceInstanceResource{}, testCommunityProviderAddress, "cloudfoundry_user_provided_service",
+			`{"id":"5e2976bb-332e-41e1-8be3-53baafea9296","name":"mq","credentials":{"password":"admin","url":"mq://localhost:9000"}}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, userProvidedServiceInstance, getMovedAttribute(t, state, path.Root("type")))
+		assert.Equal(t, `{"password":"admin","url":"mq://localhost:9000"}`, getMovedJSONAttribute(t, state, path.Root("credentials")))
+	})
+}
+
+func TestMoveState_ServiceCredentialBinding(t *testing.T) {
+	t.Parallel()
+	state, diags := moveCommunityState(t, &serviceCredentialBindingResource{}, testCommunityProviderAddress, "cloudfoundry_service_key",
+		`{"id":"e558565e-3374-429a-a822-99a61853a2da","name":"hifi","service_instance":"e9ec29ca-993d-42e2-9c5b-cb17b1972cce","params":{"permissions":"read-only"},"credentials":{"password":"s3cr3t"}}`)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "e558565e-3374-429a-a822-99a61853a2da", getMovedAttribute(t, state, path.Root("id")))
+	assert.Equal(t, keyServiceCredentialBinding, getMovedAttribute(t, state, path.Root("type")))
+	assert.Equal(t, `{"permissions":"read-only"}`, getMovedJSONAttribute(t, state, path.Root("parameters")))
+}
+
+func TestMoveState_Roles(t *testing.T) {
+	t.Parallel()
+	t.Run("happy path - move org users with a single role", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &OrgRoleResource{}, testCommunityProviderAddress, "cloudfoundry_org_users",
+			`{"id":"`+testOrgGUID+`","org":"`+testOrgGUID+`","managers":[],"billing_managers":["`+testUser2GUID+`"],"auditors":[]}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "", getMovedAttribute(t, state, path.Root("id")))
+		assert.Equal(t, testOrgGUID, getMovedAttribute(t, state, path.Root("org")))
+		assert.Equal(t, "organization_billing_manager", getMovedAttribute(t, state, path.Root("type")))
+		assert.Equal(t, testUser2GUID, getMovedAttribute(t, state, path.Root("user")))
+	})
+	t.Run("happy path - move space users with a username", func(t *testing.T) {
+		state, diags := moveCommunityState(t, &SpaceRoleResource{}, testCommunityProviderAddress, "cloudfoundry_space_users",
+			`{"id":"`+testSpaceGUID+`","space":"`+testSpaceGUID+`","developers":["`+testUser+`"]}`)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, testSpaceGUID, getMovedAttribute(t, state, path.Root("space")))
+		assert.Equal(t, "space_developer", getMovedAttribute(t, state, path.Root("type")))
+		assert.Equal(t, testUser, getMovedAttribute(t, state, path.Root("username")))
+	})
+	t.Run("error path - move org users with several roles", func(t *testing.T) {
+		_, diags := moveCommunityState(t, &OrgRoleResource{}, testCommunityProviderAddress, "cloudfoundry_org_users",
+			`{"id":"`+testOrgGUID+`","org":"`+testOrgGUID+`","managers":["`+testUser2GUID+`"],"auditors":["`+testUser+`"]}`)
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "found 2 role assignments")
+	})
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	cfconfig "github.com/cloudfoundry/go-cfclient/v3/config"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -21,7 +29,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ provider.ProviderWithListResources = &CloudFoundryProvider{}
+var (
+	_ provider.ProviderWithListResources      = &CloudFoundryProvider{}
+	_ provider.ProviderWithEphemeralResources = &CloudFoundryProvider{}
+	_ provider.ProviderWithFunctions          = &CloudFoundryProvider{}
+	_ provider.ProviderWithActions            = &CloudFoundryProvider{}
+)
 
 type CloudFoundryProvider struct {
 	version    string
@@ -29,18 +42,42 @@ type CloudFoundryProvider struct {
 }
 
 type CloudFoundryProviderModel struct {
-	Endpoint          types.String `tfsdk:"api_url"`
-	User              types.String `tfsdk:"user"`
-	Password          types.String `tfsdk:"password"`
-	CFClientID        types.String `tfsdk:"cf_client_id"`
-	CFClientSecret    types.String `tfsdk:"cf_client_secret"`
-	SkipSslValidation types.Bool   `tfsdk:"skip_ssl_validation"`
-	Origin            types.String `tfsdk:"origin"`
-	AccessToken       types.String `tfsdk:"access_token"`
-	RefreshToken      types.String `tfsdk:"refresh_token"`
-	AssertionToken    types.String `tfsdk:"assertion_token"`
+	Endpoint              types.String `tfsdk:"api_url"`
+	User                  types.String `tfsdk:"user"`
+	Password              types.String `tfsdk:"password"`
+	CFClientID            types.String `tfsdk:"cf_client_id"`
+	CFClientSecret        types.String `tfsdk:"cf_client_secret"`
+	SkipSslValidation     types.Bool   `tfsdk:"skip_ssl_validation"`
+	Origin                types.String `tfsdk:"origin"`
+	AccessToken           types.String `tfsdk:"access_token"`
+	RefreshToken          types.String `tfsdk:"refresh_token"`
+	AssertionToken        types.String `tfsdk:"assertion_token"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait          types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait          types.String `tfsdk:"retry_max_wait"`
+	MaxRequestsPerSecond  types.Int64  `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	DisableLookupCache    types.Bool   `tfsdk:"disable_lookup_cache"`
+	CACert                types.String `tfsdk:"ca_cert"`
+	CACertFile            types.String `tfsdk:"ca_cert_file"`
+	ClientCert            types.String `tfsdk:"client_cert"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	OIDC                  *oidcModel   `tfsdk:"oidc"`
+	TokenFile             types.String `tfsdk:"token_file"`
+	CredentialProcess     types.String `tfsdk:"credential_process"`
+}
+
+type oidcModel struct {
+	RequestURL   types.String `tfsdk:"request_url"`
+	RequestToken types.String `tfsdk:"request_token"`
+	Audience     types.String `tfsdk:"audience"`
+	TokenFile    types.String `tfsdk:"token_file"`
+	TokenEnv     types.String `tfsdk:"token_env"`
 }
 
+var durationRegexp = regexp.MustCompile(`^([0-9]+(ms|s|m|h))+$`)
+
 func (p *CloudFoundryProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "cloudfoundry"
 	resp.Version = p.version
@@ -122,6 +159,174 @@ func (p *CloudFoundryProvider) Schema(ctx context.Context, req provider.SchemaRe
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of retries of idempotent requests which failed with a transient error, i.e. a network error or the status 429, 502, 503 or 504. Set to 0 to disable retries. Defaults to %d.", managers.DefaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The wait before the first retry e.g. 500ms or 2s, which doubles with every further retry. Defaults to %s.", managers.DefaultRetryMinWait),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegexp, "must be a duration e.g. 500ms, 2s or 1m"),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The maximum wait between two retries e.g. 30s or 1m. A `Retry-After` header of the API is honoured up to this wait. Defaults to %s.", managers.DefaultRetryMaxWait),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegexp, "must be a duration e.g. 500ms, 2s or 1m"),
+				},
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests per second which the provider sends to the Cloud Foundry API, including the requests of the MTA client. Defaults to 0, which means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests which the provider has in flight to the Cloud Foundry API at a time, including the requests of the MTA client. Defaults to 0, which means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "Authenticates with the OIDC token of the CI platform, e.g. GitHub Actions or GitLab CI, which is exchanged for a Cloud Foundry token with the JWT bearer grant. A new OIDC token is fetched whenever the provider has to authenticate again during a long apply. Without further attributes, the token is requested from GitHub Actions.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"request_url": schema.StringAttribute{
+						MarkdownDescription: "URL of the token service of the CI platform. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_URL` environment variable of GitHub Actions.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"request_token": schema.StringAttribute{
+						MarkdownDescription: "Bearer token for the token service of the CI platform. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variable of GitHub Actions.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Audience of the token requested from the token service, which has to match the configuration of the identity provider in UAA.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file with the OIDC token, which is read again whenever a new token is needed.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("token_env"), path.MatchRelative().AtParent().AtName("request_url")),
+						},
+					},
+					"token_env": schema.StringAttribute{
+						MarkdownDescription: "Name of the environment variable with the OIDC token, e.g. the variable of an `id_tokens` entry in GitLab CI.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("request_url")),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("user"),
+						path.MatchRoot("password"),
+						path.MatchRoot("cf_client_id"),
+						path.MatchRoot("cf_client_secret"),
+						path.MatchRoot("access_token"),
+						path.MatchRoot("refresh_token"),
+						path.MatchRoot("assertion_token"),
+					),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a JSON file with the `access_token` and `refresh_token` of Cloud Foundry, which is read again whenever the access token expires, e.g. a file whose tokens are rotated by a secrets agent.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("credential_process"),
+						path.MatchRoot("user"),
+						path.MatchRoot("password"),
+						path.MatchRoot("cf_client_id"),
+						path.MatchRoot("cf_client_secret"),
+						path.MatchRoot("access_token"),
+						path.MatchRoot("refresh_token"),
+						path.MatchRoot("assertion_token"),
+						path.MatchRoot("oidc"),
+					),
+				},
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "Command which prints a JSON object with the `access_token` and `refresh_token` of Cloud Foundry, e.g. `/usr/local/bin/cf-credentials --json`. The command is run by the shell of the platform whenever the access token expires.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("user"),
+						path.MatchRoot("password"),
+						path.MatchRoot("cf_client_id"),
+						path.MatchRoot("cf_client_secret"),
+						path.MatchRoot("access_token"),
+						path.MatchRoot("refresh_token"),
+						path.MatchRoot("assertion_token"),
+						path.MatchRoot("oidc"),
+					),
+				},
+			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate of the CA which issued the certificates of the Cloud Foundry API, UAA and MTA deployer, e.g. a private CA of the foundation. It is trusted in addition to the CAs of the system.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file with the PEM encoded certificate of the CA which issued the certificates of the Cloud Foundry API, UAA and MTA deployer. It is trusted in addition to the CAs of the system.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS with the Cloud Foundry API, UAA and MTA deployer, requires client_key.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS, requires client_cert.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy for all requests of the provider, e.g. `http://proxy.example.com:3128`. Defaults to the proxy of the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"disable_lookup_cache": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Disables the cache of name lookups, e.g. of orgs, spaces, domains, stacks and service plans, which is shared by all data sources and resources. Cached lookups expire after %.0f minutes and on any change made by the provider.", managers.DefaultLookupCacheTTL.Minutes()),
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -144,7 +349,7 @@ func addTypeCastAttributeError(resp *provider.ConfigureResponse, expectedType st
 func checkConfigUnknown(config *CloudFoundryProviderModel, resp *provider.ConfigureResponse) {
 	_, cfconfigerr := cfconfig.NewFromCFHome()
 
-	anyParamExists := !config.User.IsUnknown() || !config.Password.IsUnknown() || !config.CFClientID.IsUnknown() || !config.CFClientSecret.IsUnknown() || !config.AccessToken.IsUnknown() || !config.RefreshToken.IsUnknown() || !config.AssertionToken.IsUnknown()
+	anyParamExists := !config.User.IsUnknown() || !config.Password.IsUnknown() || !config.CFClientID.IsUnknown() || !config.CFClientSecret.IsUnknown() || !config.AccessToken.IsUnknown() || !config.RefreshToken.IsUnknown() || !config.AssertionToken.IsUnknown() || config.OIDC != nil || !config.TokenFile.IsUnknown() || !config.CredentialProcess.IsUnknown()
 
 	/*
 		There can be 3 cases of error:
@@ -175,7 +380,7 @@ func checkConfigUnknown(config *CloudFoundryProviderModel, resp *provider.Config
 func checkConfig(resp *provider.ConfigureResponse, config managers.CloudFoundryProviderConfig) {
 	_, cfconfigerr := cfconfig.NewFromCFHome()
 
-	anyParamExists := config.User != "" || config.Password != "" || config.CFClientID != "" || config.CFClientSecret != "" || config.AccessToken != "" || config.RefreshToken != "" || config.AssertionToken != ""
+	anyParamExists := config.User != "" || config.Password != "" || config.CFClientID != "" || config.CFClientSecret != "" || config.AccessToken != "" || config.RefreshToken != "" || config.AssertionToken != "" || config.OIDC != nil || config.TokenFile != "" || config.CredentialProcess != ""
 	// There can be 3 cases of error:
 	// 1. If endpoint is empty and any other parameter is set
 	// 2. If endpoint is set and all other parameter is empty
@@ -206,15 +411,25 @@ func getAndSetProviderValues(config *CloudFoundryProviderModel, resp *provider.C
 	// with Terraform configuration value if set.
 
 	c := managers.CloudFoundryProviderConfig{
-		Endpoint:       os.Getenv("CF_API_URL"),
-		User:           os.Getenv("CF_USER"),
-		Password:       os.Getenv("CF_PASSWORD"),
-		CFClientID:     os.Getenv("CF_CLIENT_ID"),
-		CFClientSecret: os.Getenv("CF_CLIENT_SECRET"),
-		Origin:         os.Getenv("CF_ORIGIN"),
-		AccessToken:    os.Getenv("CF_ACCESS_TOKEN"),
-		RefreshToken:   os.Getenv("CF_REFRESH_TOKEN"),
-		AssertionToken: os.Getenv("CF_ASSERTION_TOKEN"),
+		Endpoint:          os.Getenv("CF_API_URL"),
+		User:              os.Getenv("CF_USER"),
+		Password:          os.Getenv("CF_PASSWORD"),
+		CFClientID:        os.Getenv("CF_CLIENT_ID"),
+		CFClientSecret:    os.Getenv("CF_CLIENT_SECRET"),
+		Origin:            os.Getenv("CF_ORIGIN"),
+		AccessToken:       os.Getenv("CF_ACCESS_TOKEN"),
+		RefreshToken:      os.Getenv("CF_REFRESH_TOKEN"),
+		AssertionToken:    os.Getenv("CF_ASSERTION_TOKEN"),
+		TokenFile:         os.Getenv("CF_TOKEN_FILE"),
+		CredentialProcess: os.Getenv("CF_CREDENTIAL_PROCESS"),
+		CACert:            os.Getenv("CF_CA_CERT"),
+		CACertFile:        os.Getenv("CF_CA_CERT_FILE"),
+		ClientCert:        os.Getenv("CF_CLIENT_CERT"),
+		ClientKey:         os.Getenv("CF_CLIENT_KEY"),
+		ProxyURL:          os.Getenv("CF_PROXY_URL"),
+		MaxRetries:        managers.DefaultMaxRetries,
+		RetryMinWait:      managers.DefaultRetryMinWait,
+		RetryMaxWait:      managers.DefaultRetryMaxWait,
 	}
 
 	var err error
@@ -225,6 +440,48 @@ func getAndSetProviderValues(config *CloudFoundryProviderModel, resp *provider.C
 			return nil
 		}
 	}
+	if os.Getenv("CF_DISABLE_LOOKUP_CACHE") != "" {
+		c.DisableLookupCache, err = strconv.ParseBool(os.Getenv("CF_DISABLE_LOOKUP_CACHE"))
+		if err != nil {
+			addTypeCastAttributeError(resp, "Boolean", "disable_lookup_cache", "Disable Lookup Cache", "CF_DISABLE_LOOKUP_CACHE")
+			return nil
+		}
+	}
+	if os.Getenv("CF_MAX_RETRIES") != "" {
+		c.MaxRetries, err = strconv.Atoi(os.Getenv("CF_MAX_RETRIES"))
+		if err != nil || c.MaxRetries < 0 {
+			addTypeCastAttributeError(resp, "non-negative Integer", "max_retries", "Max Retries", "CF_MAX_RETRIES")
+			return nil
+		}
+	}
+	if os.Getenv("CF_MAX_REQUESTS_PER_SECOND") != "" {
+		c.MaxRequestsPerSecond, err = strconv.Atoi(os.Getenv("CF_MAX_REQUESTS_PER_SECOND"))
+		if err != nil || c.MaxRequestsPerSecond < 0 {
+			addTypeCastAttributeError(resp, "non-negative Integer", "max_requests_per_second", "Max Requests Per Second", "CF_MAX_REQUESTS_PER_SECOND")
+			return nil
+		}
+	}
+	if os.Getenv("CF_MAX_CONCURRENT_REQUESTS") != "" {
+		c.MaxConcurrentRequests, err = strconv.Atoi(os.Getenv("CF_MAX_CONCURRENT_REQUESTS"))
+		if err != nil || c.MaxConcurrentRequests < 0 {
+			addTypeCastAttributeError(resp, "non-negative Integer", "max_concurrent_requests", "Max Concurrent Requests", "CF_MAX_CONCURRENT_REQUESTS")
+			return nil
+		}
+	}
+	if os.Getenv("CF_RETRY_MIN_WAIT") != "" {
+		c.RetryMinWait, err = time.ParseDuration(os.Getenv("CF_RETRY_MIN_WAIT"))
+		if err != nil {
+			addTypeCastAttributeError(resp, "Duration", "retry_min_wait", "Retry Min Wait", "CF_RETRY_MIN_WAIT")
+			return nil
+		}
+	}
+	if os.Getenv("CF_RETRY_MAX_WAIT") != "" {
+		c.RetryMaxWait, err = time.ParseDuration(os.Getenv("CF_RETRY_MAX_WAIT"))
+		if err != nil {
+			addTypeCastAttributeError(resp, "Duration", "retry_max_wait", "Retry Max Wait", "CF_RETRY_MAX_WAIT")
+			return nil
+		}
+	}
 	if !config.Endpoint.IsNull() {
 		c.Endpoint = config.Endpoint.ValueString()
 	}
@@ -252,6 +509,37 @@ func getAndSetProviderValues(config *CloudFoundryProviderModel, resp *provider.C
 	if !config.AssertionToken.IsNull() {
 		c.AssertionToken = config.AssertionToken.ValueString()
 	}
+	if !config.TokenFile.IsNull() {
+		c.TokenFile = config.TokenFile.ValueString()
+	}
+	if !config.CredentialProcess.IsNull() {
+		c.CredentialProcess = config.CredentialProcess.ValueString()
+	}
+	if c.TokenFile != "" && c.CredentialProcess != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Invalid field token_file",
+			"Only one of token_file and credential_process can be set.",
+		)
+		return nil
+	}
+	// A token file or credential process from the environment would otherwise take precedence over the credentials of
+	// the configuration without notice.
+	envSource := config.TokenFile.IsNull() && config.CredentialProcess.IsNull() && (c.TokenFile != "" || c.CredentialProcess != "")
+	if envSource && (c.User != "" || c.Password != "" || c.CFClientID != "" || c.CFClientSecret != "" || c.AccessToken != "" || c.RefreshToken != "" || c.AssertionToken != "" || config.OIDC != nil) {
+		resp.Diagnostics.AddError(
+			"Conflicting credentials",
+			"CF_TOKEN_FILE or CF_CREDENTIAL_PROCESS is set in the environment together with other credentials. "+
+				"Unset the environment variable or remove the other credentials, as only one kind of credentials can be used.",
+		)
+		return nil
+	}
+	if config.OIDC != nil {
+		c.OIDC = getOIDCValues(config.OIDC, resp)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+	}
 
 	checkConfig(resp, c)
 	if resp.Diagnostics.HasError() {
@@ -260,10 +548,115 @@ func getAndSetProviderValues(config *CloudFoundryProviderModel, resp *provider.C
 	if !config.SkipSslValidation.IsNull() {
 		c.SkipSslValidation = config.SkipSslValidation.ValueBool()
 	}
+	if !config.MaxRetries.IsNull() {
+		c.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.CACert.IsNull() {
+		c.CACert = config.CACert.ValueString()
+	}
+	if !config.CACertFile.IsNull() {
+		c.CACertFile = config.CACertFile.ValueString()
+	}
+	if !config.ClientCert.IsNull() {
+		c.ClientCert = config.ClientCert.ValueString()
+	}
+	if !config.ClientKey.IsNull() {
+		c.ClientKey = config.ClientKey.ValueString()
+	}
+	if !config.ProxyURL.IsNull() {
+		c.ProxyURL = config.ProxyURL.ValueString()
+	}
+	if !config.DisableLookupCache.IsNull() {
+		c.DisableLookupCache = config.DisableLookupCache.ValueBool()
+	}
+	if !config.MaxRequestsPerSecond.IsNull() {
+		c.MaxRequestsPerSecond = int(config.MaxRequestsPerSecond.ValueInt64())
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		c.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+	// The durations have been validated by the schema already.
+	if !config.RetryMinWait.IsNull() {
+		c.RetryMinWait, _ = time.ParseDuration(config.RetryMinWait.ValueString())
+	}
+	if !config.RetryMaxWait.IsNull() {
+		c.RetryMaxWait, _ = time.ParseDuration(config.RetryMaxWait.ValueString())
+	}
+	if c.RetryMinWait > c.RetryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid field retry_max_wait",
+			fmt.Sprintf("The maximum wait between retries %s must not be shorter than the minimum wait %s.", c.RetryMaxWait, c.RetryMinWait),
+		)
+		return nil
+	}
+	if c.CACert != "" && c.CACertFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert"),
+			"Invalid field ca_cert",
+			"Only one of ca_cert and ca_cert_file can be set.",
+		)
+		return nil
+	}
+	switch {
+	case c.ClientCert != "" && c.ClientKey == "":
+		addGenericAttributeError(resp, "Missing", "client_key", "Client Key", "CF_CLIENT_KEY")
+		return nil
+	case c.ClientCert == "" && c.ClientKey != "":
+		addGenericAttributeError(resp, "Missing", "client_cert", "Client Certificate", "CF_CLIENT_CERT")
+		return nil
+	}
+	if c.ProxyURL != "" {
+		if proxyURL, err := url.Parse(c.ProxyURL); err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid field proxy_url",
+				fmt.Sprintf("The proxy URL %s must be an absolute URL e.g. http://proxy.example.com:3128.", c.ProxyURL),
+			)
+			return nil
+		}
+	}
 	c.Endpoint = strings.TrimSuffix(c.Endpoint, "/")
 
 	return &c
 }
+
+// Returns the OIDC configuration, the token service defaults to the one of GitHub Actions.
+func getOIDCValues(config *oidcModel, resp *provider.ConfigureResponse) *managers.OIDCConfig {
+	c := managers.OIDCConfig{
+		RequestURL:   os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"),
+		RequestToken: os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"),
+		Audience:     config.Audience.ValueString(),
+		TokenFile:    config.TokenFile.ValueString(),
+		TokenEnv:     config.TokenEnv.ValueString(),
+	}
+	if !config.RequestURL.IsNull() {
+		c.RequestURL = config.RequestURL.ValueString()
+	}
+	if !config.RequestToken.IsNull() {
+		c.RequestToken = config.RequestToken.ValueString()
+	}
+	if c.TokenFile != "" || c.TokenEnv != "" {
+		return &c
+	}
+	switch {
+	case c.RequestURL == "":
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc").AtName("request_url"),
+			"Missing field oidc.request_url",
+			"The provider cannot get an OIDC token as none of token_file, token_env and request_url is set. "+
+				"In GitHub Actions, grant the id-token: write permission to the job, so that the ACTIONS_ID_TOKEN_REQUEST_URL environment variable is set.",
+		)
+	case c.RequestToken == "":
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc").AtName("request_token"),
+			"Missing field oidc.request_token",
+			"The provider cannot request an OIDC token from the token service as neither request_token nor the ACTIONS_ID_TOKEN_REQUEST_TOKEN environment variable is set.",
+		)
+	}
+	return &c
+}
+
 func (p *CloudFoundryProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config CloudFoundryProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -294,6 +687,8 @@ func (p *CloudFoundryProvider) Configure(ctx context.Context, req provider.Confi
 	resp.DataSourceData = session
 	resp.ResourceData = session
 	resp.ListResourceData = session
+	resp.EphemeralResourceData = session
+	resp.ActionData = session
 }
 
 func (p *CloudFoundryProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -323,6 +718,14 @@ func (p *CloudFoundryProvider) Resources(ctx context.Context) []func() resource.
 		NewCFUserResource,
 		NewServicePlanVisibilityResource,
 		NewNetworkPolicyResource,
+		NewAppTaskResource,
+		NewAppDropletResource,
+		NewFeatureFlagResource,
+		NewEnvironmentVariableGroupResource,
+		NewAppFeaturesResource,
+		NewSpaceFeaturesResource,
+		NewOrgDefaultIsolationSegmentResource,
+		NewSpaceIsolationSegmentResource,
 	}
 }
 
@@ -370,6 +773,9 @@ func (p *CloudFoundryProvider) DataSources(ctx context.Context) []func() datasou
 		NewOrgQuotasDataSource,
 		NewSecurityGroupsDataSource,
 		NewStacksDataSource,
+		NewAppRevisionsDataSource,
+		NewAppPackageDataSource,
+		NewFeatureFlagsDataSource,
 	}
 }
 
@@ -396,6 +802,33 @@ func (p *CloudFoundryProvider) ListResources(_ context.Context) []func() list.Li
 	}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *CloudFoundryProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewServiceCredentialBindingEphemeralResource,
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *CloudFoundryProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseSizeFunction,
+		NewFormatSizeFunction,
+		NewParseRouteFunction,
+		NewMtaDescriptorFunction,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *CloudFoundryProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewAppRestartAction,
+		NewAppRestageAction,
+		NewAppScaleAction,
+	}
+}
+
 func New(version string, httpClient *http.Client) func() provider.Provider {
 	return func() provider.Provider {
 		return &CloudFoundryProvider{
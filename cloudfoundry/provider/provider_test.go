@@ -10,11 +10,16 @@ import (
 	"text/template"
 
 	cfconfig "github.com/cloudfoundry/go-cfclient/v3/config"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	testingResource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 
@@ -22,16 +27,26 @@ import (
 )
 
 type CloudFoundryProviderConfigPtr struct {
-	Endpoint          *string
-	User              *string
-	Password          *string
-	CFClientID        *string
-	CFClientSecret    *string
-	SkipSslValidation *bool
-	Origin            *string
-	AccessToken       *string
-	RefreshToken      *string
-	AssertionToken    *string
+	Endpoint              *string
+	User                  *string
+	Password              *string
+	CFClientID            *string
+	CFClientSecret        *string
+	SkipSslValidation     *bool
+	Origin                *string
+	AccessToken           *string
+	RefreshToken          *string
+	AssertionToken        *string
+	MaxRetries            *int
+	RetryMinWait          *string
+	RetryMaxWait          *string
+	MaxRequestsPerSecond  *int
+	MaxConcurrentRequests *int
+	DisableLookupCache    *bool
+	ClientCert            *string
+	ProxyURL              *string
+	OIDCTokenFile         *string
+	TokenFile             *string
 }
 
 var redactedTestUser = CloudFoundryProviderConfigPtr{
@@ -80,6 +95,38 @@ func hclProvider(cfConfig *CloudFoundryProviderConfigPtr) string {
 			{{if .AssertionToken}}
 				assertion_token = "{{.AssertionToken}}"
 			{{- end }}
+			{{if .MaxRetries}}
+				max_retries = {{.MaxRetries}}
+			{{- end }}
+			{{if .RetryMinWait}}
+				retry_min_wait = "{{.RetryMinWait}}"
+			{{- end }}
+			{{if .RetryMaxWait}}
+				retry_max_wait = "{{.RetryMaxWait}}"
+			{{- end }}
+			{{if .MaxRequestsPerSecond}}
+				max_requests_per_second = {{.MaxRequestsPerSecond}}
+			{{- end }}
+			{{if .MaxConcurrentRequests}}
+				max_concurrent_requests = {{.MaxConcurrentRequests}}
+			{{- end }}
+			{{if .DisableLookupCache}}
+				disable_lookup_cache = {{.DisableLookupCache}}
+			{{- end }}
+			{{if .ClientCert}}
+				client_cert = "{{.ClientCert}}"
+			{{- end }}
+			{{if .ProxyURL}}
+				proxy_url = "{{.ProxyURL}}"
+			{{- end }}
+			{{if .TokenFile}}
+				token_file = "{{.TokenFile}}"
+			{{- end }}
+			{{if .OIDCTokenFile}}
+				oidc = {
+					token_file = "{{.OIDCTokenFile}}"
+				}
+			{{- end }}
 			}`
 		tmpl, err := template.New("provider").Parse(s)
 		if err != nil {
@@ -156,6 +203,105 @@ func TestCloudFoundryProvider_Configure(t *testing.T) {
 			},
 		})
 	})
+	t.Run("error path - invalid retry and request limit configuration", func(t *testing.T) {
+
+		testingResource.Test(t, testingResource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(http.DefaultClient),
+			Steps: []testingResource.TestStep{
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:   redactedTestUser.Endpoint,
+						User:       redactedTestUser.User,
+						Password:   redactedTestUser.Password,
+						MaxRetries: new(-1),
+					}),
+					ExpectError: regexp.MustCompile(`Attribute max_retries value must be at least 0`),
+				},
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:     redactedTestUser.Endpoint,
+						User:         redactedTestUser.User,
+						Password:     redactedTestUser.Password,
+						RetryMinWait: new("two seconds"),
+					}),
+					ExpectError: regexp.MustCompile(`Attribute retry_min_wait must be a duration`),
+				},
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:     redactedTestUser.Endpoint,
+						User:         redactedTestUser.User,
+						Password:     redactedTestUser.Password,
+						RetryMinWait: new("1m"),
+						RetryMaxWait: new("10s"),
+					}),
+					ExpectError: regexp.MustCompile(`Error: Invalid field retry_max_wait`),
+				},
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:              redactedTestUser.Endpoint,
+						User:                  redactedTestUser.User,
+						Password:              redactedTestUser.Password,
+						MaxConcurrentRequests: new(-5),
+					}),
+					ExpectError: regexp.MustCompile(`Attribute max_concurrent_requests value must be at least 0`),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid tls and proxy configuration", func(t *testing.T) {
+
+		testingResource.Test(t, testingResource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(http.DefaultClient),
+			Steps: []testingResource.TestStep{
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:   redactedTestUser.Endpoint,
+						User:       redactedTestUser.User,
+						Password:   redactedTestUser.Password,
+						ClientCert: new("-----BEGIN CERTIFICATE-----"),
+					}),
+					ExpectError: regexp.MustCompile(`Attribute "client_key" must be specified when "client_cert" is\s+specified`),
+				},
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint: redactedTestUser.Endpoint,
+						User:     redactedTestUser.User,
+						Password: redactedTestUser.Password,
+						ProxyURL: new("proxy.example.com"),
+					}),
+					ExpectError: regexp.MustCompile(`Error: Invalid field proxy_url`),
+				},
+			},
+		})
+	})
+	t.Run("error path - oidc or token file together with other credentials", func(t *testing.T) {
+
+		testingResource.Test(t, testingResource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(http.DefaultClient),
+			Steps: []testingResource.TestStep{
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:      redactedTestUser.Endpoint,
+						User:          redactedTestUser.User,
+						Password:      redactedTestUser.Password,
+						OIDCTokenFile: new("/var/run/secrets/tokens/cf-token"),
+					}),
+					ExpectError: regexp.MustCompile(`Error: Invalid Attribute Combination`),
+				},
+				{
+					Config: hclProviderWithDataSource(&CloudFoundryProviderConfigPtr{
+						Endpoint:    redactedTestUser.Endpoint,
+						AccessToken: redactedTestUser.AccessToken,
+						TokenFile:   new("/var/run/secrets/cf/tokens.json"),
+					}),
+					ExpectError: regexp.MustCompile(`Error: Invalid Attribute Combination`),
+				},
+			},
+		})
+	})
 	t.Run("user login with valid user/pass data", func(t *testing.T) {
 		endpoint := new(os.Getenv("TEST_CF_API_URL"))
 		user := new(os.Getenv("TEST_CF_USER"))
@@ -273,6 +419,13 @@ func getProviders(httpClient *http.Client) map[string]func() (tfprotov6.Provider
 		"cloudfoundry": providerserver.NewProtocol6WithError(New("test", httpClient)()),
 	}
 }
+
+// Adds the echo provider which persists ephemeral values in the state of the echo resource so that they can be checked.
+func getProvidersWithEcho(httpClient *http.Client) map[string]func() (tfprotov6.ProviderServer, error) {
+	providers := getProviders(httpClient)
+	providers["echo"] = echoprovider.NewProviderServer()
+	return providers
+}
 func getCFHomeConf() *CloudFoundryProviderConfigPtr {
 	cfConf, err := cfconfig.NewFromCFHome()
 	if err != nil {
@@ -291,6 +444,40 @@ func stopQuietly(rec *recorder.Recorder) {
 		panic(err)
 	}
 }
+
+// Not parallel, as the credential source is read from the environment.
+func TestCloudFoundryProvider_ConfigureWithEnvCredentialSource(t *testing.T) {
+	t.Run("happy path - token file from the environment", func(t *testing.T) {
+		t.Setenv("CF_TOKEN_FILE", "/var/run/secrets/cf/tokens.json")
+		resp := &provider.ConfigureResponse{}
+		c := getAndSetProviderValues(&CloudFoundryProviderModel{Endpoint: types.StringValue(*redactedTestUser.Endpoint)}, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		if assert.NotNil(t, c) {
+			assert.Equal(t, "/var/run/secrets/cf/tokens.json", c.TokenFile)
+		}
+	})
+	t.Run("error path - token file from the environment together with user/password of the configuration", func(t *testing.T) {
+		t.Setenv("CF_TOKEN_FILE", "/var/run/secrets/cf/tokens.json")
+		resp := &provider.ConfigureResponse{}
+		c := getAndSetProviderValues(&CloudFoundryProviderModel{
+			Endpoint: types.StringValue(*redactedTestUser.Endpoint),
+			User:     types.StringValue(*redactedTestUser.User),
+			Password: types.StringValue(*redactedTestUser.Password),
+		}, resp)
+		assert.Nil(t, c)
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Conflicting credentials", resp.Diagnostics.Errors()[0].Summary())
+	})
+	t.Run("error path - credential process and access token from the environment", func(t *testing.T) {
+		t.Setenv("CF_CREDENTIAL_PROCESS", "vault read -format=json cf/tokens")
+		t.Setenv("CF_ACCESS_TOKEN", *redactedTestUser.AccessToken)
+		resp := &provider.ConfigureResponse{}
+		c := getAndSetProviderValues(&CloudFoundryProviderModel{Endpoint: types.StringValue(*redactedTestUser.Endpoint)}, resp)
+		assert.Nil(t, c)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
 func TestCloudFoundryProvider_HasResources(t *testing.T) {
 	expectedResources := []string{
 		"cloudfoundry_org",
@@ -318,6 +505,14 @@ func TestCloudFoundryProvider_HasResources(t *testing.T) {
 		"cloudfoundry_service_plan_visibility",
 		"cloudfoundry_user_cf",
 		"cloudfoundry_network_policy",
+		"cloudfoundry_app_task",
+		"cloudfoundry_app_droplet",
+		"cloudfoundry_feature_flag",
+		"cloudfoundry_environment_variable_group",
+		"cloudfoundry_app_features",
+		"cloudfoundry_space_features",
+		"cloudfoundry_org_default_isolation_segment",
+		"cloudfoundry_space_isolation_segment",
 	}
 
 	ctx := context.Background()
@@ -378,6 +573,9 @@ func TestProvider_HasDataSources(t *testing.T) {
 		"cloudfoundry_org_quotas",
 		"cloudfoundry_security_groups",
 		"cloudfoundry_stacks",
+		"cloudfoundry_app_revisions",
+		"cloudfoundry_app_package",
+		"cloudfoundry_feature_flags",
 	}
 
 	ctx := context.Background()
@@ -441,3 +639,99 @@ func TestCloudFoundryProvider_HasListResources(t *testing.T) {
 
 	assert.ElementsMatch(t, expected, registered)
 }
+
+func TestCloudFoundryProvider_HasEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []string{
+		"cloudfoundry_service_credential_binding",
+		"cloudfoundry_access_token",
+	}
+
+	p := New("test", &http.Client{})()
+	ephemeralProvider, ok := p.(provider.ProviderWithEphemeralResources)
+	if !ok {
+		t.Fatalf("provider does not implement ProviderWithEphemeralResources")
+	}
+
+	var registered []string
+
+	for _, ephemeralResourceFunc := range ephemeralProvider.EphemeralResources(ctx) {
+		var resp ephemeral.MetadataResponse
+
+		ephemeralResourceFunc().Metadata(
+			ctx,
+			ephemeral.MetadataRequest{
+				ProviderTypeName: "cloudfoundry",
+			},
+			&resp,
+		)
+
+		registered = append(registered, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expected, registered)
+}
+
+func TestCloudFoundryProvider_HasFunctions(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []string{
+		"parse_size",
+		"format_size",
+		"parse_route",
+		"mta_descriptor",
+	}
+
+	p := New("test", &http.Client{})()
+	functionProvider, ok := p.(provider.ProviderWithFunctions)
+	if !ok {
+		t.Fatalf("provider does not implement ProviderWithFunctions")
+	}
+
+	var registered []string
+
+	for _, functionFunc := range functionProvider.Functions(ctx) {
+		var resp function.MetadataResponse
+
+		functionFunc().Metadata(ctx, function.MetadataRequest{}, &resp)
+
+		registered = append(registered, resp.Name)
+	}
+
+	assert.ElementsMatch(t, expected, registered)
+}
+
+func TestCloudFoundryProvider_HasActions(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []string{
+		"cloudfoundry_app_restart",
+		"cloudfoundry_app_restage",
+		"cloudfoundry_app_scale",
+	}
+
+	p := New("test", &http.Client{})()
+	actionProvider, ok := p.(provider.ProviderWithActions)
+	if !ok {
+		t.Fatalf("provider does not implement ProviderWithActions")
+	}
+
+	var registered []string
+
+	for _, actionFunc := range actionProvider.Actions(ctx) {
+		var resp action.MetadataResponse
+
+		actionFunc().Metadata(
+			ctx,
+			action.MetadataRequest{
+				ProviderTypeName: "cloudfoundry",
+			},
+			&resp,
+		)
+
+		registered = append(registered, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expected, registered)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,7 +16,9 @@ import (
 	cfv3operation "github.com/cloudfoundry/go-cfclient/v3/operation"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -35,6 +39,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v2"
 )
 
@@ -50,8 +55,11 @@ const (
 )
 
 var (
-	_ resource.Resource              = &appResource{}
-	_ resource.ResourceWithConfigure = &appResource{}
+	_ resource.Resource                   = &appResource{}
+	_ resource.ResourceWithConfigure      = &appResource{}
+	_ resource.ResourceWithValidateConfig = &appResource{}
+	_ resource.ResourceWithModifyPlan     = &appResource{}
+	_ resource.ResourceWithMoveState      = &appResource{}
 )
 
 func NewAppResource() resource.Resource {
@@ -60,13 +68,14 @@ func NewAppResource() resource.Resource {
 
 type appResource struct {
 	cfClient *cfv3client.Client
+	lookups  *managers.LookupCache
 }
 
 func (r *appResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app"
 }
 
-func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *appResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Provides a Cloud Foundry resource to manage applications.",
 		Attributes: map[string]schema.Attribute{
@@ -150,11 +159,94 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					},
 				},
 			},
+			"lifecycle_type": schema.StringAttribute{
+				MarkdownDescription: "The lifecycle used to stage the application. Valid values are 'buildpack', 'cnb' and 'docker'. If not set, 'docker' is used for apps with a docker_image and 'buildpack' otherwise.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("buildpack", "cnb", "docker"),
+				},
+			},
+			"cnb_credentials": schema.MapNestedAttribute{
+				MarkdownDescription: "Credentials for private registries hosting the Cloud Native Buildpacks, keyed by the registry host e.g. registry.example.com. Used only when lifecycle_type is set to 'cnb'.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username for the registry.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("password")),
+							},
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "The password for the registry.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
+							},
+						},
+						"token": schema.StringAttribute{
+							MarkdownDescription: "The token for the registry. Mutually exclusive with username and password.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("username")),
+							},
+						},
+					},
+				},
+			},
 			"strategy": schema.StringAttribute{
-				MarkdownDescription: "The deployment strategy to use when deploying the application. Valid values are 'none', 'rolling', and 'blue-green', defaults to 'none'.",
+				MarkdownDescription: "The deployment strategy to use when deploying the application. Valid values are 'none', 'rolling', 'blue-green', and 'canary', defaults to 'none'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "rolling", "blue-green", "canary"),
+				},
+			},
+			"canary_steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The steps of a deployment with 'canary' strategy. The deployment pauses after each step and is continued once the new instances are healthy. If not set, Cloud Foundry starts a single canary instance. Used only when strategy is set to 'canary'.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_weight": schema.Int64Attribute{
+							MarkdownDescription: "The percentage of instances running the new version after this step.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 100),
+							},
+						},
+						"pause": schema.StringAttribute{
+							MarkdownDescription: "The time to observe the new instances before continuing with the next step e.g. 30s or 5m. Defaults to no pause.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(ms|s|m|h))+$`), "must be a duration e.g. 30s, 5m or 1h"),
+							},
+						},
+					},
+				},
+			},
+			"max_in_flight": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of new instances to start simultaneously during a deployment with 'rolling' or 'canary' strategy. Defaults to 1 on Cloud Foundry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"revision": schema.StringAttribute{
+				MarkdownDescription: "The GUID or version number of a revision to pin the application to. When set, no bits are pushed and the revision is deployed with 'canary' strategy if configured or 'rolling' strategy otherwise, restoring its droplet, environment variables and start commands. Can only be set for an existing, started application and not with 'blue-green' strategy. While set, path, source_code_hash, docker_image and cnb_credentials cannot be changed.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("none", "rolling", "blue-green"),
+					stringvalidator.Any(
+						validation.ValidUUID(),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a revision version"),
+					),
 				},
 			},
 			"service_bindings": schema.SetNestedAttribute{
@@ -249,14 +341,14 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 			"app_deployed_running_timeout": schema.Int64Attribute{
-				MarkdownDescription: "Timeout in minutes to wait for app to be running after updating deployment with 'blue-green' strategy. The default is 5 minutes. Min value is 1 minute. Used only when strategy is set to 'blue-green'.",
+				MarkdownDescription: "Timeout in minutes to wait for app to be running after updating deployment with 'blue-green' strategy or for each step of a deployment with 'canary' strategy or 'rolling' strategy with max_in_flight set. The default is 5 minutes. Min value is 1 minute. Used only when strategy is set to 'blue-green', 'canary' or 'rolling'.",
 				Optional:            true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(AppDeployedRunningTimeoutMinutesMinimum),
 				},
 			},
 			"app_deployed_running_check_interval": schema.Int64Attribute{
-				MarkdownDescription: "The interval in seconds between checks to see if the app is running after updating deployment with 'blue-green' strategy. The default is 5 seconds. Min value is 1 second, max value is 30 seconds. Used only when strategy is set to 'blue-green'.",
+				MarkdownDescription: "The interval in seconds between checks to see if the app is running after updating deployment with 'blue-green', 'canary' or 'rolling' strategy with max_in_flight set. The default is 5 seconds. Min value is 1 second, max value is 30 seconds. Used only when strategy is set to 'blue-green', 'canary' or 'rolling'.",
 				Optional:            true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(AppDeployedRunningCheckIntervalSecondsMinimum),
@@ -332,6 +424,43 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Computed:            true,
 			},
 			updatedAtKey: updatedAtSchema(),
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the latest deployment of the application. Only set if strategy is 'canary', 'rolling' with max_in_flight or a revision is pinned.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_status": schema.StringAttribute{
+				MarkdownDescription: "The status value of the latest deployment e.g. ACTIVE or FINALIZED.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason for the status of the latest deployment e.g. DEPLOYING, PAUSED, DEPLOYED or CANCELED.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_revision_id": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the revision currently deployed for the application. Only set if strategy is 'canary', 'rolling' with max_in_flight or a revision is pinned.",
+				Computed:            true,
+			},
+			"current_revision_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the revision currently deployed for the application.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the app including staging and rolling out its instances. If not set, the push is not limited and only the jobs it waits for time out after 20 minutes each",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the app including staging and rolling out its instances. If not set, the push is not limited and only the jobs it waits for time out after 20 minutes each",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the app. Default is 20 minutes",
+			}),
 		},
 	}
 	for k, v := range r.ProcessAppCommonSchema() {
@@ -440,6 +569,129 @@ func (r *appResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 	r.cfClient = session.CFClient
+	r.lookups = session.Lookups
+}
+
+func (r *appResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		strategy       types.String
+		canarySteps    types.List
+		maxInFlight    types.Int64
+		lifecycle      types.String
+		dockerImage    types.String
+		cnbCredentials types.Map
+		revision       types.String
+	)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("canary_steps"), &canarySteps)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_in_flight"), &maxInFlight)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lifecycle_type"), &lifecycle)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("docker_image"), &dockerImage)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cnb_credentials"), &cnbCredentials)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("revision"), &revision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !lifecycle.IsNull() && !lifecycle.IsUnknown() && !dockerImage.IsUnknown() {
+		if lifecycle.ValueString() == "docker" && dockerImage.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("lifecycle_type"),
+				"invalid attribute combination",
+				"docker_image must be set when lifecycle_type is docker",
+			)
+		}
+		if lifecycle.ValueString() != "docker" && !dockerImage.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("lifecycle_type"),
+				"invalid attribute combination",
+				"docker_image can only be set when lifecycle_type is docker",
+			)
+		}
+	}
+	if !cnbCredentials.IsNull() && !lifecycle.IsUnknown() && lifecycle.ValueString() != "cnb" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cnb_credentials"),
+			"invalid attribute combination",
+			"cnb_credentials can only be set when lifecycle_type is cnb",
+		)
+	}
+	if strategy.IsUnknown() {
+		return
+	}
+
+	if !canarySteps.IsNull() && strategy.ValueString() != "canary" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("canary_steps"),
+			"invalid attribute combination",
+			"canary_steps can only be set when strategy is canary",
+		)
+	}
+	if !maxInFlight.IsNull() && strategy.ValueString() != "rolling" && strategy.ValueString() != "canary" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_in_flight"),
+			"invalid attribute combination",
+			"max_in_flight can only be set when strategy is rolling or canary",
+		)
+	}
+	if !revision.IsNull() && strategy.ValueString() == "blue-green" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revision"),
+			"invalid attribute combination",
+			"revision cannot be set when strategy is blue-green, a pinned revision is deployed with rolling or canary strategy",
+		)
+	}
+}
+
+func (r *appResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is pushed on creation, deletion or if the app did not change.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan, state AppType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A pinned revision is deployed without pushing any bits, so changes of the bits would be lost silently.
+	if !plan.Revision.IsNull() {
+		bits := []struct {
+			name    string
+			changed bool
+		}{
+			{"path", !plan.Path.Equal(state.Path)},
+			{"source_code_hash", !plan.SourceCodeHash.Equal(state.SourceCodeHash)},
+			{"docker_image", !plan.DockerImage.Equal(state.DockerImage)},
+			{"cnb_credentials", !plan.CNBCredentials.Equal(state.CNBCredentials)},
+		}
+		for _, attribute := range bits {
+			if attribute.changed {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name),
+					"invalid attribute combination",
+					fmt.Sprintf("%s cannot be changed while revision is set, as no bits are pushed for a pinned revision. Remove revision to push the new bits.", attribute.name),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Every push of an app whose deployments are tracked may create a new deployment and deploy a new revision.
+	if plan.tracksDeployments() {
+		plan.DeploymentID = types.StringUnknown()
+		plan.DeploymentStatus = types.StringUnknown()
+		plan.DeploymentReason = types.StringUnknown()
+		plan.CurrentRevisionID = types.StringUnknown()
+		plan.CurrentRevisionVersion = types.Int64Unknown()
+	} else {
+		plan.mapDeploymentValuesToType(nil, nil)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *appResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -487,6 +739,10 @@ func (r *appResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if plan.Stopped.IsNull() || plan.Stopped.IsUnknown() {
 		plan.Stopped = appType.Stopped
 	}
+	if err = r.setDeploymentAndRevision(ctx, &plan, &appType); err != nil {
+		resp.Diagnostics.AddError("Error reading app deployment", err.Error())
+		return
+	}
 	resp.State.Set(ctx, &plan)
 }
 
@@ -513,6 +769,16 @@ func (r *appResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 	if appManifestValue.Instances == nil {
 		appManifestValue.Instances = new(uint(1))
 	}
+	var timeout time.Duration
+	if reqState == nil {
+		timeout, diags = desiredState.Timeouts.Create(ctx, defaultTimeout)
+	} else {
+		timeout, diags = desiredState.Timeouts.Update(ctx, defaultTimeout)
+	}
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
 	if reqState != nil {
 		diags = reqState.Get(ctx, &previousState)
 		respDiags.Append(diags...)
@@ -522,8 +788,20 @@ func (r *appResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 		respDiags.Append(diags...)
 	}
 
+	// Rollouts may take much longer than the default timeout, so the push is only limited if a timeout is configured.
+	pushCtx := ctx
+	operation := "update"
+	if reqState == nil {
+		operation = "create"
+	}
+	if timeoutConfigured(desiredState.Timeouts, operation) {
+		var cancel context.CancelFunc
+		pushCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	curTime := time.Now()
-	appResp, err := r.push(desiredState, appManifestValue, ctx)
+	appResp, err := r.push(desiredState, appManifestValue, pushCtx, timeout)
 
 	if err != nil {
 
@@ -557,10 +835,18 @@ func (r *appResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 	respDiags.Append(diags...)
 	plan.CopyConfigAttributes(&desiredState)
 	plan.Stopped = desiredState.Stopped
+	if err = r.setDeploymentAndRevision(ctx, &plan, nil); err != nil {
+		respDiags.AddError("Error reading app deployment", err.Error())
+		return
+	}
 	respDiags.Append(respState.Set(ctx, &plan)...)
 
 }
-func (r *appResource) push(appType AppType, appManifestValue *cfv3operation.AppManifest, ctx context.Context) (*cfv3resource.App, error) {
+
+func (r *appResource) push(appType AppType, appManifestValue *cfv3operation.AppManifest, ctx context.Context, timeout time.Duration) (*cfv3resource.App, error) {
+	if !appType.Revision.IsNull() {
+		return r.deployRevision(ctx, appType, appManifestValue, timeout)
+	}
 	var file *os.File
 	var err error
 	if !appType.Path.IsNull() {
@@ -568,6 +854,14 @@ func (r *appResource) push(appType AppType, appManifestValue *cfv3operation.AppM
 		if err != nil {
 			return nil, err
 		}
+		defer file.Close()
+	}
+	if err = r.setCNBCredentials(ctx, appType, appManifestValue, timeout); err != nil {
+		return nil, err
+	}
+	if appType.Strategy.ValueString() == "canary" && !appType.Stopped.ValueBool() ||
+		appType.Strategy.ValueString() == "rolling" && !appType.MaxInFlight.IsNull() && !appType.Stopped.ValueBool() {
+		return r.pushWithDeployment(ctx, appType, appManifestValue, file, timeout)
 	}
 	manifestOp := cfv3operation.NewAppPushOperation(r.cfClient, appType.Org.ValueString(), appType.Space.ValueString())
 	if !appType.Strategy.IsNull() {
@@ -575,8 +869,8 @@ func (r *appResource) push(appType AppType, appManifestValue *cfv3operation.AppM
 		case "rolling":
 			manifestOp.WithStrategy(cfv3operation.StrategyRolling)
 		case "blue-green":
-			timeout, checkInterval := r.getBlueGreenDeploymentStrategyOptions(appType)
-			manifestOp.WithBlueGreenStrategy(timeout, checkInterval)
+			deployedRunningTimeout, checkInterval := r.getBlueGreenDeploymentStrategyOptions(appType)
+			manifestOp.WithBlueGreenStrategy(deployedRunningTimeout, checkInterval)
 		default:
 			manifestOp.WithStrategy(cfv3operation.StrategyNone)
 		}
@@ -587,7 +881,7 @@ func (r *appResource) push(appType AppType, appManifestValue *cfv3operation.AppM
 	appResp, err := manifestOp.Push(ctx, appManifestValue, file)
 	if err != nil {
 		if appType.Strategy.ValueString() == "blue-green" && isServiceBindingInProgressError(err) {
-			return r.cleanupVenerableApp(ctx, appType)
+			return r.cleanupVenerableApp(ctx, appType, timeout)
 		}
 		return nil, err
 	}
@@ -609,16 +903,517 @@ func (r *appResource) getBlueGreenDeploymentStrategyOptions(appType AppType) (ui
 	return timeout, checkInterval
 }
 
+// Stages the new version of an app and rolls it out with a deployment using the configured strategy and options.
+// Apps which do not exist yet or are not running are pushed directly as there are no instances to replace.
+func (r *appResource) pushWithDeployment(ctx context.Context, appType AppType, appManifestValue *cfv3operation.AppManifest, file *os.File, timeout time.Duration) (*cfv3resource.App, error) {
+	spaceGUID, app, err := r.findSpaceAndApp(ctx, appType, appManifestValue.Name)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil || app.State != "STARTED" {
+		manifestOp := cfv3operation.NewAppPushOperation(r.cfClient, appType.Org.ValueString(), appType.Space.ValueString())
+		return manifestOp.Push(ctx, appManifestValue, file)
+	}
+	if err = r.applyManifest(ctx, spaceGUID, appManifestValue, timeout); err != nil {
+		return nil, err
+	}
+
+	droplet, err := r.stageDroplet(ctx, app, appManifestValue, file)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentCreate := newAppDeploymentCreate(appType, app.GUID, appType.Strategy.ValueString())
+	deploymentCreate.Droplet = &cfv3resource.Relationship{GUID: droplet.GUID}
+	deployment, err := r.cfClient.Deployments.Create(ctx, deploymentCreate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy with: %w", err)
+	}
+	if err = r.driveDeployment(ctx, appType, deployment); err != nil {
+		return nil, err
+	}
+	return r.cfClient.Applications.Get(ctx, app.GUID)
+}
+
+// Rolls the app back or forward to the pinned revision without pushing any bits. The manifest is applied first so
+// that scaling and route changes still converge. A deployment is only created if the droplet of the currently
+// deployed revision differs from the one of the pinned revision, as Cloud Foundry records every rollback as a new revision.
+func (r *appResource) deployRevision(ctx context.Context, appType AppType, appManifestValue *cfv3operation.AppManifest, timeout time.Duration) (*cfv3resource.App, error) {
+	spaceGUID, app, err := r.findSpaceAndApp(ctx, appType, appManifestValue.Name)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil {
+		return nil, fmt.Errorf("app %s does not exist, revision can only be set for an existing app", appManifestValue.Name)
+	}
+	if app.State != "STARTED" {
+		return nil, fmt.Errorf("app %s is not started, revision can only be deployed for a started app", app.Name)
+	}
+	revision, err := r.findRevision(ctx, app.GUID, appType.Revision.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if !revision.Deployable {
+		return nil, fmt.Errorf("revision %d of app %s is not deployable as its droplet no longer exists", revision.Version, app.Name)
+	}
+	if err = r.applyManifest(ctx, spaceGUID, appManifestValue, timeout); err != nil {
+		return nil, err
+	}
+
+	deployed, err := r.cfClient.Revisions.ListForAppDeployedAll(ctx, app.GUID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deployed revisions of app %s: %w", app.Name, err)
+	}
+	for _, d := range deployed {
+		if d.Droplet.GUID == revision.Droplet.GUID {
+			tflog.Debug(ctx, "pinned revision is already deployed", map[string]any{"revision": revision.GUID, "version": revision.Version})
+			return r.cfClient.Applications.Get(ctx, app.GUID)
+		}
+	}
+
+	strategy := "rolling"
+	if appType.Strategy.ValueString() == "canary" {
+		strategy = "canary"
+	}
+	deploymentCreate := newAppDeploymentCreate(appType, app.GUID, strategy)
+	deploymentCreate.Revision = &cfv3resource.DeploymentRevision{GUID: revision.GUID}
+	deployment, err := r.cfClient.Deployments.Create(ctx, deploymentCreate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy revision %d with: %w", revision.Version, err)
+	}
+	if err = r.driveDeployment(ctx, appType, deployment); err != nil {
+		return nil, err
+	}
+	return r.cfClient.Applications.Get(ctx, app.GUID)
+}
+
+// Stores the registry credentials in the cnb lifecycle of the app so that they are available when the app is staged.
+// Cloud Foundry never returns the credentials, so apps which do not exist yet are created from the manifest first.
+func (r *appResource) setCNBCredentials(ctx context.Context, appType AppType, appManifestValue *cfv3operation.AppManifest, timeout time.Duration) error {
+	if appType.CNBCredentials.IsNull() || appType.CNBCredentials.IsUnknown() {
+		return nil
+	}
+	credentials, diags := appType.mapCNBCredentialsToValues(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("error reading cnb credentials")
+	}
+	spaceGUID, app, err := r.findSpaceAndApp(ctx, appType, appManifestValue.Name)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		if err = r.applyManifest(ctx, spaceGUID, appManifestValue, timeout); err != nil {
+			return err
+		}
+		if _, app, err = r.findSpaceAndApp(ctx, appType, appManifestValue.Name); err != nil {
+			return err
+		}
+	}
+	_, err = r.cfClient.Applications.Update(ctx, app.GUID, &cfv3resource.AppUpdate{
+		Name: app.Name,
+		Lifecycle: &cfv3resource.Lifecycle{
+			Type: string(cfv3operation.CNB),
+			Data: &cnbLifecycle{
+				Buildpacks:  appManifestValue.Buildpacks,
+				Stack:       appManifestValue.Stack,
+				Credentials: credentials,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting cnb credentials for app %s: %w", app.Name, err)
+	}
+	return nil
+}
+
+// Looks up a revision of the app either by its GUID or by its version number.
+func (r *appResource) findRevision(ctx context.Context, appGUID string, revision string) (*cfv3resource.Revision, error) {
+	if version, err := strconv.Atoi(revision); err == nil {
+		opts := cfv3client.NewRevisionListOptions()
+		opts.Versions = cfv3client.Filter{Values: []string{strconv.Itoa(version)}}
+		rev, err := r.cfClient.Revisions.SingleForApp(ctx, appGUID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not find revision %d of app %s: %w", version, appGUID, err)
+		}
+		return rev, nil
+	}
+	rev, err := r.cfClient.Revisions.Get(ctx, revision)
+	if err != nil {
+		return nil, fmt.Errorf("could not find revision %s: %w", revision, err)
+	}
+	if rev.Relationships.App.Data == nil || rev.Relationships.App.Data.GUID != appGUID {
+		return nil, fmt.Errorf("revision %s does not belong to app %s", revision, appGUID)
+	}
+	return rev, nil
+}
+
+// Returns the GUID of the space and the app with the given name in it. The app is nil if it does not exist.
+func (r *appResource) findSpaceAndApp(ctx context.Context, appType AppType, appName string) (string, *cfv3resource.App, error) {
+	orgGUID, err := lookupOrgByName(ctx, r.cfClient, r.lookups, appType.Org.ValueString())
+	if err != nil {
+		return "", nil, fmt.Errorf("could not find org %s: %w", appType.Org.ValueString(), err)
+	}
+	spaceGUID, err := lookupSpaceByName(ctx, r.cfClient, r.lookups, orgGUID, appType.Space.ValueString())
+	if err != nil {
+		return "", nil, fmt.Errorf("could not find space %s: %w", appType.Space.ValueString(), err)
+	}
+	app, err := r.cfClient.Applications.Single(ctx, &cfv3client.AppListOptions{
+		Names:      cfv3client.Filter{Values: []string{appName}},
+		SpaceGUIDs: cfv3client.Filter{Values: []string{spaceGUID}},
+	})
+	if err != nil && !errors.Is(err, cfv3client.ErrExactlyOneResultNotReturned) {
+		return "", nil, err
+	}
+	return spaceGUID, app, nil
+}
+
+func (r *appResource) applyManifest(ctx context.Context, spaceGUID string, appManifestValue *cfv3operation.AppManifest, timeout time.Duration) error {
+	manifestBytes, err := yaml.Marshal(&cfv3operation.Manifest{
+		Applications: []*cfv3operation.AppManifest{appManifestValue},
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling application manifest: %w", err)
+	}
+	jobID, err := r.cfClient.Manifests.ApplyManifest(ctx, spaceGUID, string(manifestBytes))
+	if err != nil {
+		return fmt.Errorf("error applying application manifest to space %s: %w", spaceGUID, err)
+	}
+	if err = pollJob(ctx, *r.cfClient, jobID, timeout); err != nil {
+		return fmt.Errorf("error waiting for application manifest to finish applying to space %s: %w", spaceGUID, err)
+	}
+	return nil
+}
+
+func newAppDeploymentCreate(appType AppType, appGUID string, strategy string) *cfv3resource.DeploymentCreate {
+	deploymentCreate := cfv3resource.NewDeploymentCreate(appGUID)
+	deploymentCreate.Strategy = strategy
+	if !appType.MaxInFlight.IsNull() || len(appType.CanarySteps) > 0 {
+		deploymentCreate.Options = &cfv3resource.DeploymentOptions{}
+	}
+	if !appType.MaxInFlight.IsNull() {
+		deploymentCreate.Options.MaxInFlight = new(int(appType.MaxInFlight.ValueInt64()))
+	}
+	if len(appType.CanarySteps) > 0 {
+		steps := make([]cfv3resource.CanaryStep, 0, len(appType.CanarySteps))
+		for _, step := range appType.CanarySteps {
+			steps = append(steps, cfv3resource.CanaryStep{InstanceWeight: int(step.InstanceWeight.ValueInt64())})
+		}
+		deploymentCreate.Options.Canary = &cfv3resource.DeploymentCanaryOptions{Steps: steps}
+	}
+	return deploymentCreate
+}
+
+// Uploads the bits or docker image of the app and builds a droplet from it without touching the running instances.
+func (r *appResource) stageDroplet(ctx context.Context, app *cfv3resource.App, appManifestValue *cfv3operation.AppManifest, file *os.File) (*cfv3resource.Droplet, error) {
+	var (
+		pkg      *cfv3resource.Package
+		newBuild *cfv3resource.BuildCreate
+		err      error
+	)
+	if appManifestValue.Docker != nil {
+		pkg, err = r.cfClient.Packages.Create(ctx, cfv3resource.NewDockerPackageCreate(app.GUID, appManifestValue.Docker.Image, appManifestValue.Docker.Username, os.Getenv("CF_DOCKER_PASSWORD")))
+		if err != nil {
+			return nil, fmt.Errorf("error creating docker package for app %s: %w", app.Name, err)
+		}
+		newBuild = cfv3resource.NewBuildCreate(pkg.GUID)
+		newBuild.Lifecycle = &cfv3resource.Lifecycle{
+			Type: cfv3resource.LifecycleDocker.String(),
+			Data: &cfv3resource.DockerLifecycle{},
+		}
+	} else {
+		pkg, err = r.cfClient.Packages.Create(ctx, cfv3resource.NewPackageCreate(app.GUID))
+		if err != nil {
+			return nil, fmt.Errorf("error creating package bits for app %s: %w", app.Name, err)
+		}
+		if _, err = r.cfClient.Packages.Upload(ctx, pkg.GUID, file); err != nil {
+			return nil, fmt.Errorf("error uploading package bits for app %s: %w", app.Name, err)
+		}
+		if err = r.cfClient.Packages.PollReady(ctx, pkg.GUID, nil); err != nil {
+			return nil, fmt.Errorf("error while waiting for package to process for app %s: %w", app.Name, err)
+		}
+		newBuild = cfv3resource.NewBuildCreate(pkg.GUID)
+		newBuild.Lifecycle = &cfv3resource.Lifecycle{
+			Type: cfv3resource.LifecycleBuildpack.String(),
+			Data: &cfv3resource.BuildpackLifecycle{
+				Buildpacks: appManifestValue.Buildpacks,
+				Stack:      appManifestValue.Stack,
+			},
+		}
+		if appManifestValue.Lifecycle == cfv3operation.CNB {
+			newBuild.Lifecycle = &cfv3resource.Lifecycle{
+				Type: string(cfv3operation.CNB),
+				Data: &cfv3resource.CNBLifecycle{
+					Buildpacks: appManifestValue.Buildpacks,
+					Stack:      appManifestValue.Stack,
+				},
+			}
+		}
+	}
+	build, err := r.cfClient.Builds.Create(ctx, newBuild)
+	if err != nil {
+		return nil, fmt.Errorf("error creating build from package for app %s: %w", app.Name, err)
+	}
+	if err = r.cfClient.Builds.PollStaged(ctx, build.GUID, nil); err != nil {
+		return nil, fmt.Errorf("error while waiting for app %s package to build: %w", app.Name, err)
+	}
+	opts := cfv3client.NewDropletPackageListOptions()
+	opts.States.EqualTo(cfv3resource.DropletStateStaged.String())
+	droplet, err := r.cfClient.Droplets.SingleForPackage(ctx, pkg.GUID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error finding droplet for app %s: %w", app.Name, err)
+	}
+	return droplet, nil
+}
+
+// Waits for a deployment to finish and walks canary deployments through their steps. Every time the deployment
+// pauses, the configured pause of the step is awaited and the deployment is continued if the new instances are
+// healthy. The deployment is canceled if an instance crashes or a step does not complete within the timeout.
+func (r *appResource) driveDeployment(ctx context.Context, appType AppType, deployment *cfv3resource.Deployment) error {
+	timeout, checkInterval := getRolloutTimeouts(appType)
+
+	deploymentGUID := deployment.GUID
+	continuedStep := 0
+	deadline := time.Now().Add(timeout)
+	for {
+		deployment, err := r.cfClient.Deployments.Get(ctx, deploymentGUID)
+		if err != nil {
+			return fmt.Errorf("failed to get status of deployment %s: %w", deploymentGUID, err)
+		}
+		if deployment.Status.Value == "FINALIZED" {
+			if deployment.Status.Reason == "DEPLOYED" {
+				return nil
+			}
+			return fmt.Errorf("deployment %s finished with reason %s", deploymentGUID, deployment.Status.Reason)
+		}
+
+		running, err := r.getDeploymentInstancesRunning(ctx, deployment)
+		if err != nil {
+			return r.cancelDeployment(ctx, deployment.GUID, err)
+		}
+		step := 1
+		if deployment.Status.Canary != nil && deployment.Status.Canary.Steps != nil {
+			step = deployment.Status.Canary.Steps.Current
+		}
+		if deployment.Status.Reason == "PAUSED" && running && step > continuedStep {
+			if step <= len(appType.CanarySteps) && !appType.CanarySteps[step-1].Pause.IsNull() {
+				pause, _ := time.ParseDuration(appType.CanarySteps[step-1].Pause.ValueString())
+				tflog.Info(ctx, "canary step reached, observing new instances", map[string]any{"step": step, "pause": pause.String()})
+				if err = sleepWithContext(ctx, pause); err != nil {
+					return r.cancelDeployment(ctx, deployment.GUID, err)
+				}
+				running, err = r.getDeploymentInstancesRunning(ctx, deployment)
+				if err != nil {
+					return r.cancelDeployment(ctx, deployment.GUID, err)
+				}
+				if !running {
+					return r.cancelDeployment(ctx, deployment.GUID, fmt.Errorf("instances of step %d are not running after the pause", step))
+				}
+			}
+			if err = r.cfClient.Deployments.Continue(ctx, deployment.GUID); err != nil {
+				return r.cancelDeployment(ctx, deployment.GUID, fmt.Errorf("failed to continue deployment with: %w", err))
+			}
+			continuedStep = step
+			deadline = time.Now().Add(timeout)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return r.cancelDeployment(ctx, deployment.GUID, fmt.Errorf("step %d did not complete within %s", step, timeout))
+		}
+		if err = sleepWithContext(ctx, checkInterval); err != nil {
+			return r.cancelDeployment(ctx, deployment.GUID, err)
+		}
+	}
+}
+
+// Returns how long a rollout may take per step and how often its instances are checked.
+func getRolloutTimeouts(appType AppType) (time.Duration, time.Duration) {
+	timeout := time.Duration(AppDeployedRunningTimeoutMinutesDefault) * time.Minute
+	if !appType.AppDeployedRunningTimeout.IsNull() {
+		timeout = time.Duration(appType.AppDeployedRunningTimeout.ValueInt64()) * time.Minute
+	}
+	checkInterval := time.Duration(AppDeployedRunningCheckIntervalSecondsDefault) * time.Second
+	if !appType.AppDeployedRunningCheckInterval.IsNull() {
+		checkInterval = time.Duration(appType.AppDeployedRunningCheckInterval.ValueInt64()) * time.Second
+	}
+	return timeout, checkInterval
+}
+
+// Reports whether all instances of the processes created by the deployment are running and fails if one of them crashed.
+func (r *appResource) getDeploymentInstancesRunning(ctx context.Context, deployment *cfv3resource.Deployment) (bool, error) {
+	running := true
+	for _, process := range deployment.NewProcesses {
+		processRunning, err := r.getProcessInstancesRunning(ctx, process.GUID, process.Type)
+		if err != nil {
+			return false, err
+		}
+		running = running && processRunning
+	}
+	return running, nil
+}
+
+// Reports whether all instances of a process are running and fails if one of them crashed.
+func (r *appResource) getProcessInstancesRunning(ctx context.Context, processGUID string, processType string) (bool, error) {
+	stats, err := r.cfClient.Processes.GetStats(ctx, processGUID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get stats of process %s: %w", processGUID, err)
+	}
+	running := true
+	for _, stat := range stats.Stats {
+		switch stat.State {
+		case "RUNNING":
+		case "CRASHED":
+			return false, fmt.Errorf("instance %d of process %s crashed", stat.Index, processType)
+		default:
+			running = false
+		}
+	}
+	return running, nil
+}
+
+// Restarts the instances of an app on the given droplet, or on the current one if empty. With the rolling strategy a
+// deployment replaces the instances step by step, otherwise the app is stopped and started again. Stopped apps are
+// always started directly as there are no instances to replace.
+func (r *appResource) restartApp(ctx context.Context, app *cfv3resource.App, dropletGUID string, appType AppType) error {
+	if appType.Strategy.ValueString() == "rolling" && app.State == "STARTED" {
+		deploymentCreate := newAppDeploymentCreate(appType, app.GUID, "rolling")
+		if dropletGUID != "" {
+			deploymentCreate.Droplet = &cfv3resource.Relationship{GUID: dropletGUID}
+		}
+		deployment, err := r.cfClient.Deployments.Create(ctx, deploymentCreate)
+		if err != nil {
+			return fmt.Errorf("failed to deploy with: %w", err)
+		}
+		return r.driveDeployment(ctx, appType, deployment)
+	}
+	if dropletGUID != "" {
+		if _, err := r.cfClient.Droplets.SetCurrentAssociationForApp(ctx, app.GUID, dropletGUID); err != nil {
+			return fmt.Errorf("failed to set current droplet of app %s with: %w", app.Name, err)
+		}
+	}
+	if _, err := r.cfClient.Applications.Restart(ctx, app.GUID); err != nil {
+		return fmt.Errorf("failed to restart app %s with: %w", app.Name, err)
+	}
+	return r.waitForAppInstancesRunning(ctx, app, appType)
+}
+
+// Builds a new droplet from the most recent package of the app with the lifecycle configured for the app and
+// returns its GUID.
+func (r *appResource) restageDroplet(ctx context.Context, app *cfv3resource.App) (string, error) {
+	opts := cfv3client.NewPackageListOptions()
+	opts.States.EqualTo(string(cfv3resource.PackageStateReady))
+	opts.OrderBy = "-created_at"
+	pkg, err := r.cfClient.Packages.FirstForApp(ctx, app.GUID, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to find a package to stage for app %s: %w", app.Name, err)
+	}
+	build, err := r.cfClient.Builds.Create(ctx, cfv3resource.NewBuildCreate(pkg.GUID))
+	if err != nil {
+		return "", fmt.Errorf("error creating build from package for app %s: %w", app.Name, err)
+	}
+	if err = r.cfClient.Builds.PollStaged(ctx, build.GUID, nil); err != nil {
+		return "", fmt.Errorf("error while waiting for app %s package to build: %w", app.Name, err)
+	}
+	build, err = r.cfClient.Builds.Get(ctx, build.GUID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching build of app %s: %w", app.Name, err)
+	}
+	if build.Droplet == nil {
+		return "", fmt.Errorf("build %s of app %s did not create a droplet", build.GUID, app.Name)
+	}
+	return build.Droplet.GUID, nil
+}
+
+// Waits until all instances of all processes of an app are running and fails if one of them crashes.
+func (r *appResource) waitForAppInstancesRunning(ctx context.Context, app *cfv3resource.App, appType AppType) error {
+	timeout, checkInterval := getRolloutTimeouts(appType)
+	processes, err := r.cfClient.Processes.ListForAppAll(ctx, app.GUID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch processes of app %s: %w", app.Name, err)
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		running := true
+		for _, process := range processes {
+			if process.Instances == 0 {
+				continue
+			}
+			processRunning, err := r.getProcessInstancesRunning(ctx, process.GUID, process.Type)
+			if err != nil {
+				return err
+			}
+			running = running && processRunning
+		}
+		if running {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("instances of app %s are not running after %s", app.Name, timeout)
+		}
+		if err = sleepWithContext(ctx, checkInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *appResource) cancelDeployment(ctx context.Context, deploymentGUID string, cause error) error {
+	if err := r.cfClient.Deployments.Cancel(context.WithoutCancel(ctx), deploymentGUID); err != nil {
+		return fmt.Errorf("deployment failed with: %w \nfailed to cancel deployment with: %w", cause, err)
+	}
+	return fmt.Errorf("deployment failed with: %w \ndeployment was canceled", cause)
+}
+
+// Sets the latest deployment and the currently deployed revision of the app on the given state.
+// Apps whose deployments are not tracked or without deployments or revisions get null values. The revision of the
+// previous state is kept if neither the latest deployment nor the app changed since, which saves listing the revisions
+// on every read.
+func (r *appResource) setDeploymentAndRevision(ctx context.Context, appType *AppType, previous *AppType) error {
+	if !appType.tracksDeployments() {
+		appType.mapDeploymentValuesToType(nil, nil)
+		return nil
+	}
+	opts := cfv3client.NewDeploymentListOptions()
+	opts.AppGUIDs = cfv3client.Filter{Values: []string{appType.ID.ValueString()}}
+	opts.OrderBy = "-created_at"
+	deployment, err := r.cfClient.Deployments.First(ctx, opts)
+	if err != nil && !errors.Is(err, cfv3client.ErrNoResultsReturned) {
+		return fmt.Errorf("failed to fetch deployments of app %s: %w", appType.ID.ValueString(), err)
+	}
+	var deploymentGUID string
+	if deployment != nil {
+		deploymentGUID = deployment.GUID
+	}
+	if previous != nil && !previous.CurrentRevisionID.IsNull() && previous.UpdatedAt.Equal(appType.UpdatedAt) &&
+		previous.DeploymentID.ValueString() == deploymentGUID {
+		appType.mapDeploymentValuesToType(deployment, nil)
+		appType.CurrentRevisionID = previous.CurrentRevisionID
+		appType.CurrentRevisionVersion = previous.CurrentRevisionVersion
+		return nil
+	}
+	revisions, err := r.cfClient.Revisions.ListForAppDeployedAll(ctx, appType.ID.ValueString(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch deployed revisions of app %s: %w", appType.ID.ValueString(), err)
+	}
+	var currentRevision *cfv3resource.Revision
+	for _, revision := range revisions {
+		if currentRevision == nil || revision.Version > currentRevision.Version {
+			currentRevision = revision
+		}
+	}
+	appType.mapDeploymentValuesToType(deployment, currentRevision)
+	return nil
+}
+
 func isServiceBindingInProgressError(err error) bool {
 	return strings.Contains(err.Error(), "An operation for the service binding") &&
 		strings.Contains(err.Error(), "is in progress")
 }
 
-func (r *appResource) waitForServiceBindingsReady(ctx context.Context, appGUID string) error {
+func (r *appResource) waitForServiceBindingsReady(ctx context.Context, appGUID string, timeout time.Duration) error {
 	opts := cfv3client.NewServiceCredentialBindingListOptions()
 	opts.AppGUIDs = cfv3client.Filter{Values: []string{appGUID}}
 
-	deadline := time.Now().Add(defaultTimeout)
+	deadline := time.Now().Add(timeout)
 	for {
 		if time.Now().After(deadline) {
 			return fmt.Errorf("timed out waiting for service bindings to reach a terminal state for app %s", appGUID)
@@ -645,34 +1440,29 @@ func (r *appResource) waitForServiceBindingsReady(ctx context.Context, appGUID s
 	}
 }
 
-func (r *appResource) cleanupVenerableApp(ctx context.Context, appType AppType) (*cfv3resource.App, error) {
+func (r *appResource) cleanupVenerableApp(ctx context.Context, appType AppType, timeout time.Duration) (*cfv3resource.App, error) {
 	venerableName := appType.Name.ValueString() + venerableSuffix
 
-	org, err := r.cfClient.Organizations.Single(ctx, &cfv3client.OrganizationListOptions{
-		Names: cfv3client.Filter{Values: []string{appType.Org.ValueString()}},
-	})
+	orgGUID, err := lookupOrgByName(ctx, r.cfClient, r.lookups, appType.Org.ValueString())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find org %s during venerable cleanup: %w", appType.Org.ValueString(), err)
 	}
 
-	space, err := r.cfClient.Spaces.Single(ctx, &cfv3client.SpaceListOptions{
-		Names:             cfv3client.Filter{Values: []string{appType.Space.ValueString()}},
-		OrganizationGUIDs: cfv3client.Filter{Values: []string{org.GUID}},
-	})
+	spaceGUID, err := lookupSpaceByName(ctx, r.cfClient, r.lookups, orgGUID, appType.Space.ValueString())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find space %s during venerable cleanup: %w", appType.Space.ValueString(), err)
 	}
 
 	venerableApp, err := r.cfClient.Applications.Single(ctx, &cfv3client.AppListOptions{
 		Names:      cfv3client.Filter{Values: []string{venerableName}},
-		SpaceGUIDs: cfv3client.Filter{Values: []string{space.GUID}},
+		SpaceGUIDs: cfv3client.Filter{Values: []string{spaceGUID}},
 	})
 	if err != nil && !errors.Is(err, cfv3client.ErrExactlyOneResultNotReturned) {
 		return nil, fmt.Errorf("failed to find venerable app %s: %w", venerableName, err)
 	}
 
 	if venerableApp != nil {
-		if err := r.waitForServiceBindingsReady(ctx, venerableApp.GUID); err != nil {
+		if err := r.waitForServiceBindingsReady(ctx, venerableApp.GUID, timeout); err != nil {
 			return nil, err
 		}
 		if _, err := r.cfClient.Applications.Stop(ctx, venerableApp.GUID); err != nil {
@@ -682,14 +1472,14 @@ func (r *appResource) cleanupVenerableApp(ctx context.Context, appType AppType)
 		if err != nil {
 			return nil, fmt.Errorf("failed to delete venerable app %s: %w", venerableName, err)
 		}
-		if err := pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+		if err := pollJob(ctx, *r.cfClient, jobID, timeout); err != nil {
 			return nil, fmt.Errorf("failed waiting for venerable app %s deletion: %w", venerableName, err)
 		}
 	}
 
 	newApp, err := r.cfClient.Applications.Single(ctx, &cfv3client.AppListOptions{
 		Names:      cfv3client.Filter{Values: []string{appType.Name.ValueString()}},
-		SpaceGUIDs: cfv3client.Filter{Values: []string{space.GUID}},
+		SpaceGUIDs: cfv3client.Filter{Values: []string{spaceGUID}},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to find app %s after venerable cleanup: %w", appType.Name.ValueString(), err)
@@ -704,6 +1494,12 @@ func (r *appResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := appType.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := r.cfClient.Applications.Delete(ctx, appType.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -712,7 +1508,7 @@ func (r *appResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		)
 		return
 	}
-	err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout)
+	err = pollJob(ctx, *r.cfClient, jobID, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to verify org quota deletion",
@@ -726,62 +1522,76 @@ func (r *appResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *appResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_app", mapCommunityAppState),
+	}
+}
+
 func getAppLogTrace(ctx context.Context, r *appResource, desiredState AppType, curTime time.Time) []string {
 
-	EnableCFAppLogTrace := os.Getenv("ENABLE_CF_APP_LOG_TRACE")
+	if !isAppLogTraceEnabled() {
+		return nil
+	}
+	org, _ := r.cfClient.Organizations.Single(ctx, &cfv3client.OrganizationListOptions{
+		Names: cfv3client.Filter{
+			Values: []string{desiredState.Org.ValueString()},
+		},
+	})
+	space, _ := r.cfClient.Spaces.Single(ctx, &cfv3client.SpaceListOptions{
+		Names: cfv3client.Filter{
+			Values: []string{desiredState.Space.ValueString()},
+		},
+		OrganizationGUIDs: cfv3client.Filter{
+			Values: []string{org.GUID},
+		},
+	})
+	app, _ := r.cfClient.Applications.First(ctx, &cfv3client.AppListOptions{
+		Names: cfv3client.Filter{
+			Values: []string{desiredState.Name.ValueString()},
+		},
+		OrganizationGUIDs: cfv3client.Filter{
+			Values: []string{org.GUID},
+		},
+		SpaceGUIDs: cfv3client.Filter{
+			Values: []string{space.GUID},
+		},
+	})
+	return getAppLogTraceForApp(ctx, r, app, curTime)
+}
+
+func isAppLogTraceEnabled() bool {
+	return os.Getenv("ENABLE_CF_APP_LOG_TRACE") == "true"
+}
+
+// Reads the error logs the app wrote since the given time from log cache.
+func getAppLogTraceForApp(ctx context.Context, r *appResource, app *cfv3resource.App, curTime time.Time) []string {
 	var errString []string
 	var logCacheAddr string
-	if EnableCFAppLogTrace == "true" {
-
-		org, _ := r.cfClient.Organizations.Single(ctx, &cfv3client.OrganizationListOptions{
-			Names: cfv3client.Filter{
-				Values: []string{desiredState.Org.ValueString()},
-			},
-		})
-		space, _ := r.cfClient.Spaces.Single(ctx, &cfv3client.SpaceListOptions{
-			Names: cfv3client.Filter{
-				Values: []string{desiredState.Space.ValueString()},
-			},
-			OrganizationGUIDs: cfv3client.Filter{
-				Values: []string{org.GUID},
-			},
-		})
-		app, _ := r.cfClient.Applications.First(ctx, &cfv3client.AppListOptions{
-			Names: cfv3client.Filter{
-				Values: []string{desiredState.Name.ValueString()},
-			},
-			OrganizationGUIDs: cfv3client.Filter{
-				Values: []string{org.GUID},
-			},
-			SpaceGUIDs: cfv3client.Filter{
-				Values: []string{space.GUID},
-			},
-		})
 
-		apiURL := app.Links.Self().Href
-		parsedURL, err := url.Parse(apiURL)
-		if err != nil {
-			errString = append(errString, "Error in getting cf log: "+err.Error())
-			return errString
-		}
-		host := parsedURL.Host
-		if strings.HasPrefix(host, "api.") {
-			logCacheAddr = strings.Replace(host, "api.", "log-cache.", 1)
-			logCacheAddr = "https://" + logCacheAddr
-		}
+	apiURL := app.Links.Self().Href
+	parsedURL, err := url.Parse(apiURL)
+	if err != nil {
+		errString = append(errString, "Error in getting cf log: "+err.Error())
+		return errString
+	}
+	host := parsedURL.Host
+	if strings.HasPrefix(host, "api.") {
+		logCacheAddr = strings.Replace(host, "api.", "log-cache.", 1)
+		logCacheAddr = "https://" + logCacheAddr
+	}
 
-		log_client := logclient.NewClient(logCacheAddr, logclient.WithHTTPClient(r.cfClient.HTTPAuthClient()))
-		es, err := log_client.Read(ctx, app.GUID, curTime)
-		if err != nil {
-			errString = append(errString, "Error in getting cf log: "+err.Error())
-			return errString
-		}
-		for _, e := range es {
-			logMessage := e.GetLog()
-			if logMessage.GetPayload() != nil && logMessage.GetType().String() == "ERR" {
-				payload := string(logMessage.GetPayload())
-				errString = append(errString, payload+"\n")
-			}
+	log_client := logclient.NewClient(logCacheAddr, logclient.WithHTTPClient(r.cfClient.HTTPAuthClient()))
+	es, err := log_client.Read(ctx, app.GUID, curTime)
+	if err != nil {
+		errString = append(errString, "Error in getting cf log: "+err.Error())
+		return errString
+	}
+	for _, e := range es {
+		logMessage := e.GetLog()
+		if logMessage.GetPayload() != nil && logMessage.GetType().String() == "ERR" {
+			payload := string(logMessage.GetPayload())
+			errString = append(errString, payload+"\n")
 		}
 	}
 	return errString
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &appDropletResource{}
+	_ resource.ResourceWithConfigure   = &appDropletResource{}
+	_ resource.ResourceWithImportState = &appDropletResource{}
+	_ resource.ResourceWithIdentity    = &appDropletResource{}
+)
+
+// Instantiates an app droplet resource.
+func NewAppDropletResource() resource.Resource {
+	return &appDropletResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type appDropletResource struct {
+	cfClient *cfv3client.Client
+}
+
+type appDropletResourceIdentityModel struct {
+	DropletGUID types.String `tfsdk:"droplet_guid"`
+}
+
+func (r *appDropletResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_droplet"
+}
+
+func (r *appDropletResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Provides a droplet for an app and sets it as the current droplet of the app, without staging the app again.
+		The droplet is either copied from another app or droplet, e.g. to promote the exact same build across spaces, or uploaded from a local tarball.
+		The app has to be restarted for the droplet to take effect. Destroying the resource deletes the droplet.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app which the droplet belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app whose current droplet is copied.",
+				Optional:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("source_droplet"),
+						path.MatchRoot("path"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_droplet": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the droplet which is copied. If `source_app` is set, the GUID of the current droplet of the source app at the time of the copy.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of a gzip compressed tarball containing a Cloud Foundry compatible droplet e.g. one downloaded from another environment.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_code_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the file specified. Terraform relies on this to detect the file changes.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("path"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"process_types": schema.MapAttribute{
+				MarkdownDescription: "Process names and start commands of the droplet. Can only be set when the droplet is uploaded from `path`, copied droplets keep the process types of their source.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("path"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIfConfigured(),
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the droplet.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lifecycle_type": schema.StringAttribute{
+				MarkdownDescription: "The lifecycle used to stage the droplet, one of buildpack, cnb or docker.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack": schema.StringAttribute{
+				MarkdownDescription: "The root filesystem the droplet was staged with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"buildpacks": schema.ListAttribute{
+				MarkdownDescription: "The names of the buildpacks that were used to stage the droplet.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image": schema.StringAttribute{
+				MarkdownDescription: "The docker image of the droplet. Only set for droplets of docker apps.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The checksum of the droplet bits.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			idKey:          guidSchema(),
+			labelsKey:      resourceLabelsSchema(),
+			annotationsKey: resourceAnnotationsSchema(),
+			createdAtKey:   createdAtSchema(),
+			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "app droplet", timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *appDropletResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"droplet_guid": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *appDropletResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *appDropletResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppDropletType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		droplet *cfv3resource.Droplet
+		err     error
+	)
+	if !plan.Path.IsNull() {
+		createDroplet, diags := plan.mapCreateAppDropletTypeToValues(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		droplet, err = r.uploadDroplet(ctx, plan.Path.ValueString(), createDroplet, createTimeout)
+	} else {
+		droplet, err = r.copyDroplet(ctx, &plan, createTimeout)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Creating App Droplet",
+			"Could not create droplet for app "+plan.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	// Droplets cannot be created with metadata, so the labels and annotations are set afterwards.
+	if !plan.Labels.IsNull() || !plan.Annotations.IsNull() {
+		metadata, diags := setClientMetadataForUpdate(ctx, types.MapNull(types.StringType), types.MapNull(types.StringType), plan.Labels, plan.Annotations)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updated, err := r.cfClient.Droplets.Update(ctx, droplet.GUID, &cfv3resource.DropletUpdate{
+			Metadata: metadata,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Updating App Droplet",
+				"Could not set metadata of the droplet with ID "+droplet.GUID+" : "+r.discardDroplet(ctx, droplet.GUID, createTimeout, err).Error(),
+			)
+			return
+		}
+		droplet = updated
+	}
+
+	_, err = r.cfClient.Droplets.SetCurrentAssociationForApp(ctx, plan.App.ValueString(), droplet.GUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Setting Current Droplet",
+			"Could not set droplet "+droplet.GUID+" as current droplet of app "+plan.App.ValueString()+" : "+r.discardDroplet(ctx, droplet.GUID, createTimeout, err).Error(),
+		)
+		return
+	}
+
+	data, diags := mapAppDropletValuesToType(ctx, droplet, plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created an app droplet resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := appDropletResourceIdentityModel{
+		DropletGUID: types.StringValue(data.Id.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+// Copies the configured source droplet or the current droplet of the source app to the app and waits until the copy is staged.
+func (r *appDropletResource) copyDroplet(ctx context.Context, plan *AppDropletType, timeout time.Duration) (*cfv3resource.Droplet, error) {
+	if !plan.SourceApp.IsNull() {
+		sourceDroplet, err := r.cfClient.Droplets.GetCurrentForApp(ctx, plan.SourceApp.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not get current droplet of source app %s : %w", plan.SourceApp.ValueString(), err)
+		}
+		plan.SourceDroplet = types.StringValue(sourceDroplet.GUID)
+	}
+
+	copied, err := r.cfClient.Droplets.Copy(ctx, plan.SourceDroplet.ValueString(), plan.App.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("could not copy droplet %s : %w", plan.SourceDroplet.ValueString(), err)
+	}
+	droplet, ok := copied.(*cfv3resource.Droplet)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T when copying droplet %s", copied, plan.SourceDroplet.ValueString())
+	}
+
+	staged, err := pollDroplet(ctx, *r.cfClient, droplet.GUID, timeout)
+	if err != nil {
+		return nil, r.discardDroplet(ctx, droplet.GUID, timeout, err)
+	}
+	return staged, nil
+}
+
+// Creates an empty droplet for the app and uploads the bits from the given tarball.
+func (r *appDropletResource) uploadDroplet(ctx context.Context, tarball string, createDroplet *cfv3resource.DropletCreate, timeout time.Duration) (*cfv3resource.Droplet, error) {
+	file, err := os.Open(tarball)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file %s : %w", filepath.Base(tarball), err)
+	}
+	defer file.Close()
+
+	droplet, err := r.cfClient.Droplets.Create(ctx, createDroplet)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, _, err := r.cfClient.Droplets.Upload(ctx, droplet.GUID, file)
+	if err != nil {
+		return nil, r.discardDroplet(ctx, droplet.GUID, timeout, fmt.Errorf("could not upload droplet %s : %w", droplet.GUID, err))
+	}
+	if jobID != "" {
+		if err = pollJob(ctx, *r.cfClient, jobID, timeout); err != nil {
+			return nil, r.discardDroplet(ctx, droplet.GUID, timeout, fmt.Errorf("failed in uploading droplet %s : %w", droplet.GUID, err))
+		}
+	}
+
+	staged, err := pollDroplet(ctx, *r.cfClient, droplet.GUID, timeout)
+	if err != nil {
+		return nil, r.discardDroplet(ctx, droplet.GUID, timeout, err)
+	}
+	return staged, nil
+}
+
+// Deletes the droplet which could not be created completely, so that it is not left behind without being in the state,
+// and adds the outcome to the given error.
+func (r *appDropletResource) discardDroplet(ctx context.Context, guid string, timeout time.Duration, err error) error {
+	if deleteErr := r.deleteDroplet(ctx, guid, timeout); deleteErr != nil {
+		return fmt.Errorf("%w\nThe droplet %s could not be deleted again and has to be removed manually : %s", err, guid, deleteErr.Error())
+	}
+	return fmt.Errorf("%w\nThe droplet %s has been deleted again", err, guid)
+}
+
+// Deletes the droplet and waits for the asynchronous deletion to finish. A droplet which does not exist anymore is
+// considered deleted.
+func (r *appDropletResource) deleteDroplet(ctx context.Context, guid string, timeout time.Duration) error {
+	jobID, err := r.cfClient.Droplets.Delete(ctx, guid)
+	if err != nil {
+		if cfv3resource.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if jobID != "" {
+		if err := pollJob(ctx, *r.cfClient, jobID, timeout); err != nil {
+			return fmt.Errorf("droplet deletion verification failed for %s: %w", guid, err)
+		}
+	}
+	return nil
+}
+
+func (r *appDropletResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppDropletType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	droplet, err := r.cfClient.Droplets.Get(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "droplet", data.Id.ValueString())
+		return
+	}
+
+	data, diags = mapAppDropletValuesToType(ctx, droplet, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "read an app droplet resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := appDropletResourceIdentityModel{
+		DropletGUID: types.StringValue(data.Id.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *appDropletResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, previousState AppDropletType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, diags := setClientMetadataForUpdate(ctx, previousState.Labels, previousState.Annotations, plan.Labels, plan.Annotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	droplet, err := r.cfClient.Droplets.Update(ctx, plan.Id.ValueString(), &cfv3resource.DropletUpdate{
+		Metadata: metadata,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating App Droplet",
+			"Could not update the droplet with ID "+plan.Id.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	data, diags := mapAppDropletValuesToType(ctx, droplet, plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated an app droplet resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := appDropletResourceIdentityModel{
+		DropletGUID: types.StringValue(data.Id.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *appDropletResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AppDropletType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.deleteDroplet(ctx, state.Id.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Deleting App Droplet",
+			"Could not delete the droplet with ID "+state.Id.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an app droplet resource")
+}
+
+func (r *appDropletResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("droplet_guid"), req, resp)
+}
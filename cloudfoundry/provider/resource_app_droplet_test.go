@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AppDropletModelPtr struct {
+	HclType        string
+	HclObjectName  string
+	App            *string
+	SourceApp      *string
+	SourceDroplet  *string
+	Path           *string
+	SourceCodeHash *string
+	ProcessTypes   *string
+	Labels         *string
+	Annotations    *string
+}
+
+func hclAppDroplet(admp *AppDropletModelPtr) string {
+	if admp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_app_droplet" {{.HclObjectName}} {
+			{{- if .App}}
+				app = "{{.App}}"
+			{{- end -}}
+			{{if .SourceApp}}
+				source_app = "{{.SourceApp}}"
+			{{- end -}}
+			{{if .SourceDroplet}}
+				source_droplet = "{{.SourceDroplet}}"
+			{{- end -}}
+			{{if .Path}}
+				path = "{{.Path}}"
+			{{- end -}}
+			{{if .SourceCodeHash}}
+				source_code_hash = "{{.SourceCodeHash}}"
+			{{- end -}}
+			{{if .ProcessTypes}}
+				process_types = {{.ProcessTypes}}
+			{{- end -}}
+			{{if .Labels}}
+				labels = {{.Labels}}
+			{{- end -}}
+			{{if .Annotations}}
+				annotations = {{.Annotations}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_app_droplet").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, admp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return admp.HclType + ` "cloudfoundry_app_droplet" ` + admp.HclObjectName + ` {}`
+}
+
+func TestAppDropletResource_Configure(t *testing.T) {
+	var (
+		resourceName   = "cloudfoundry_app_droplet.rs"
+		sourceAppGUID  = "4f1e7b2c-8d3a-4c5e-9b6f-0a1d2e3f4a5b"
+		targetAppGUID  = "9c2d4e6f-1a3b-4c5d-8e7f-6a5b4c3d2e1f"
+		invalidAppGUID = "00000000-0000-0000-0000-000000000000"
+		dropletPath    = "../../assets/cf-sample-app-nodejs-droplet.tgz"
+		processTypes   = `{ web = "node index.js" }`
+	)
+	t.Parallel()
+	t.Run("happy path - copy droplet from source app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_droplet_copy")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &targetAppGUID,
+						SourceApp:     &sourceAppGUID,
+						Labels:        &testCreateLabel,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestMatchResourceAttr(resourceName, "source_droplet", regexpValidUUID),
+						resource.TestMatchResourceAttr(resourceName, "created_at", regexpValidRFC3999Format),
+						resource.TestCheckResourceAttr(resourceName, "app", targetAppGUID),
+						resource.TestCheckResourceAttr(resourceName, "state", "STAGED"),
+						resource.TestCheckResourceAttr(resourceName, "lifecycle_type", "buildpack"),
+						resource.TestCheckResourceAttr(resourceName, "stack", "cflinuxfs4"),
+						resource.TestCheckResourceAttr(resourceName, "buildpacks.0", "nodejs_buildpack"),
+						resource.TestCheckResourceAttr(resourceName, "process_types.web", "npm start"),
+						resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+						resource.TestCheckResourceAttr(resourceName, "labels.purpose", "testing"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &targetAppGUID,
+						SourceApp:     &sourceAppGUID,
+						Labels:        &testUpdateLabel,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "labels.purpose", "production"),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"source_app", "source_droplet"},
+				},
+			},
+		})
+	})
+	t.Run("happy path - upload droplet from tarball", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_droplet_upload")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:        hclObjectResource,
+						HclObjectName:  "rs",
+						App:            &targetAppGUID,
+						Path:           &dropletPath,
+						SourceCodeHash: new("1234567890"),
+						ProcessTypes:   &processTypes,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestCheckNoResourceAttr(resourceName, "source_droplet"),
+						resource.TestCheckResourceAttr(resourceName, "state", "STAGED"),
+						resource.TestCheckResourceAttr(resourceName, "process_types.web", "node index.js"),
+						resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - copy droplet from non-existent app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_droplet_invalid_source")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &targetAppGUID,
+						SourceApp:     &invalidAppGUID,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Creating App Droplet`),
+				},
+			},
+		})
+	})
+	t.Run("error path - droplet is deleted again when setting the metadata fails", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_droplet_invalid_label")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &targetAppGUID,
+						Path:          &dropletPath,
+						Labels:        new(`{ "invalid key!" = "testing" }`),
+					}),
+					ExpectError: regexp.MustCompile(`(?s)API Error Updating App Droplet.*has been deleted again`),
+				},
+			},
+		})
+	})
+	t.Run("error path - source of droplet missing", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppDroplet(&AppDropletModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &targetAppGUID,
+						ProcessTypes:  &processTypes,
+					}),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		})
+	})
+}
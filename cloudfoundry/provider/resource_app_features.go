@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &appFeaturesResource{}
+	_ resource.ResourceWithConfigure   = &appFeaturesResource{}
+	_ resource.ResourceWithImportState = &appFeaturesResource{}
+)
+
+// Instantiates an app features resource.
+func NewAppFeaturesResource() resource.Resource {
+	return &appFeaturesResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type appFeaturesResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *appFeaturesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_features"
+}
+
+func (r *appFeaturesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the features of an app. Only the configured features are changed, the others keep the value set on the platform. Destroying the resource leaves the features of the app unchanged.
+
+__Note:__ Do not set ` + "`enable_ssh`" + ` of the ` + "`cloudfoundry_app`" + ` resource when managing the ssh feature with this resource.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh": schema.BoolAttribute{
+				MarkdownDescription: "Whether SSH access to the app instances is enabled. SSH must also be allowed for the space.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revisions": schema.BoolAttribute{
+				MarkdownDescription: "Whether revisions are created for the app when its droplet or environment changes.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_binding_k8s": schema.BoolAttribute{
+				MarkdownDescription: "Whether the credentials of service bindings are provided as files in the app container following the Kubernetes service binding specification instead of the VCAP_SERVICES environment variable. The app has to be restarted for a change to take effect.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *appFeaturesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *appFeaturesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan appFeaturesType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.updateAppFeatures(ctx, plan, appFeaturesType{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating App Features",
+			"Could not update features of app "+plan.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an app features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appFeaturesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data appFeaturesType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	features, _, err := r.cfClient.AppFeatures.List(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "app features", data.Id.ValueString())
+		return
+	}
+
+	data = mapAppFeaturesValuesToType(data.Id.ValueString(), features)
+
+	tflog.Trace(ctx, "read an app features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appFeaturesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, previousState appFeaturesType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.updateAppFeatures(ctx, plan, previousState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating App Features",
+			"Could not update features of app "+plan.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "updated an app features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appFeaturesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The features of an app cannot be removed, the resource is only removed from the state.
+	tflog.Trace(ctx, "deleted an app features resource")
+}
+
+func (r *appFeaturesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Updates the features whose planned value differs from the previous state and reads back all features of the app.
+func (r *appFeaturesResource) updateAppFeatures(ctx context.Context, plan appFeaturesType, previousState appFeaturesType) (appFeaturesType, error) {
+	plannedFeatures, previousFeatures := plan.featureValues(), previousState.featureValues()
+	for _, name := range appFeatureNames {
+		enabled := plannedFeatures[name]
+		if enabled.IsNull() || enabled.IsUnknown() || enabled.Equal(previousFeatures[name]) {
+			continue
+		}
+		if _, err := r.cfClient.AppFeatures.Update(ctx, plan.App.ValueString(), name, enabled.ValueBool()); err != nil {
+			return appFeaturesType{}, fmt.Errorf("could not set feature %s : %w", name, err)
+		}
+	}
+
+	features, _, err := r.cfClient.AppFeatures.List(ctx, plan.App.ValueString())
+	if err != nil {
+		return appFeaturesType{}, err
+	}
+	return mapAppFeaturesValuesToType(plan.App.ValueString(), features), nil
+}
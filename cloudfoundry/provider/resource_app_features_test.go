@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AppFeaturesModelPtr struct {
+	HclType           string
+	HclObjectName     string
+	App               *string
+	SSH               *bool
+	Revisions         *bool
+	ServiceBindingK8s *bool
+}
+
+func hclAppFeatures(afmp *AppFeaturesModelPtr) string {
+	if afmp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_app_features" {{.HclObjectName}} {
+			{{- if .App}}
+				app = "{{.App}}"
+			{{- end -}}
+			{{if .SSH}}
+				ssh = {{.SSH}}
+			{{- end -}}
+			{{if .Revisions}}
+				revisions = {{.Revisions}}
+			{{- end -}}
+			{{if .ServiceBindingK8s}}
+				service_binding_k8s = {{.ServiceBindingK8s}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_app_features").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, afmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return afmp.HclType + ` "cloudfoundry_app_features" ` + afmp.HclObjectName + ` {}`
+}
+
+func TestAppFeaturesResource_Configure(t *testing.T) {
+	var (
+		resourceName = "cloudfoundry_app_features.rs"
+		testAppGUID  = "9c2d4e6f-1a3b-4c5d-8e7f-6a5b4c3d2e1f"
+	)
+	t.Parallel()
+	t.Run("happy path - create/update/import app features", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_features_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppFeatures(&AppFeaturesModelPtr{
+						HclType:           hclObjectResource,
+						HclObjectName:     "rs",
+						App:               &testAppGUID,
+						ServiceBindingK8s: new(true),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", testAppGUID),
+						resource.TestCheckResourceAttr(resourceName, "service_binding_k8s", "true"),
+						resource.TestCheckResourceAttr(resourceName, "ssh", "true"),
+						resource.TestCheckResourceAttr(resourceName, "revisions", "true"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppFeatures(&AppFeaturesModelPtr{
+						HclType:           hclObjectResource,
+						HclObjectName:     "rs",
+						App:               &testAppGUID,
+						SSH:               new(false),
+						Revisions:         new(false),
+						ServiceBindingK8s: new(true),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "service_binding_k8s", "true"),
+						resource.TestCheckResourceAttr(resourceName, "ssh", "false"),
+						resource.TestCheckResourceAttr(resourceName, "revisions", "false"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportStateId:     testAppGUID,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
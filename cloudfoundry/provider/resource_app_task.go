@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &appTaskResource{}
+	_ resource.ResourceWithConfigure = &appTaskResource{}
+)
+
+// Instantiates an app task resource.
+func NewAppTaskResource() resource.Resource {
+	return &appTaskResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type appTaskResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *appTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_task"
+}
+
+func (r *appTaskResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Runs a one-off task such as a database migration in the context of an app and waits for it to finish.
+		The task is run again whenever one of the configured attributes or the ` + "`triggers`" + ` change. Destroying the resource does not undo the effects of the task.`,
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the app in which context the task is run.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"command": schema.StringAttribute{
+				MarkdownDescription: "The command that will be executed.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the task. If not provided, a name is generated by Cloud Foundry.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"memory": schema.StringAttribute{
+				MarkdownDescription: "The memory limit for the task e.g. 512M or 1G. If not provided, the default of the platform is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_quota": schema.StringAttribute{
+				MarkdownDescription: "The disk space to be allocated for the task e.g. 512M or 1G. If not provided, the default of the platform is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_rate_limit_per_second": schema.StringAttribute{
+				MarkdownDescription: "The log rate limit for the task e.g. 16K. -1 represents an unlimited amount. If not provided, the default of the platform is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the task again e.g. the GUID of the droplet or a version of the database schema.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sequence_id": schema.Int64Attribute{
+				MarkdownDescription: "User-facing id of the task. This number is unique for every task associated with a given app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the task. One of PENDING, RUNNING, SUCCEEDED, CANCELING or FAILED.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failure_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason the task failed. Only set when the task is in state FAILED.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"droplet_guid": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the droplet that was used to run the command.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			idKey:          guidSchema(),
+			labelsKey:      resourceLabelsSchema(),
+			annotationsKey: resourceAnnotationsSchema(),
+			createdAtKey:   createdAtSchema(),
+			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "app task", timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *appTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *appTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppTaskType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTask, diags := plan.mapCreateAppTaskTypeToValues(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.cfClient.Tasks.Create(ctx, plan.App.ValueString(), createTask)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Creating App Task",
+			"Could not create task for app "+plan.App.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	polledTask, err := pollTask(ctx, *r.cfClient, task.GUID, createTimeout)
+	if polledTask != nil {
+		task = polledTask
+	}
+
+	// The state is stored even if the task failed so that the failure reason can be inspected.
+	// Terraform marks the resource as tainted and the task is run again with the next apply.
+	data, diags := mapAppTaskValuesToType(ctx, task, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"App Task Failed",
+			"Task "+task.Name+" of app "+plan.App.ValueString()+" did not succeed : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an app task resource")
+}
+
+func (r *appTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppTaskType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.cfClient.Tasks.Get(ctx, data.Id.ValueString())
+	if err != nil {
+		// Cloud Foundry prunes completed tasks after a while. Removing the resource from the state would
+		// run the task again, so the last known state is kept as long as the task has not been replaced.
+		if cfv3resource.IsResourceNotFoundError(err) {
+			tflog.Debug(ctx, "app task not found, keeping last known state", map[string]any{"task": data.Id.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"API Error Reading App Task",
+			"Could not get details of the task with ID "+data.Id.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	data, diags = mapAppTaskValuesToType(ctx, task, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "read an app task resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, previousState AppTaskType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, diags := setClientMetadataForUpdate(ctx, previousState.Labels, previousState.Annotations, plan.Labels, plan.Annotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.cfClient.Tasks.Update(ctx, plan.Id.ValueString(), &cfv3resource.TaskUpdate{
+		Metadata: metadata,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating App Task",
+			"Could not update the task with ID "+plan.Id.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	data, diags := mapAppTaskValuesToType(ctx, task, plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated an app task resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AppTaskType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tasks cannot be deleted, only a task which is still in progress is canceled.
+	task, err := r.cfClient.Tasks.Get(ctx, state.Id.ValueString())
+	if err != nil {
+		if cfv3resource.IsResourceNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"API Error Reading App Task",
+			"Could not get details of the task with ID "+state.Id.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	if task.State == taskStatePending || task.State == taskStateRunning {
+		_, err = r.cfClient.Tasks.Cancel(ctx, task.GUID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Canceling App Task",
+				"Could not cancel the task with ID "+task.GUID+" : "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted an app task resource")
+}
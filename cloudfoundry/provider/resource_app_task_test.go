@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type AppTaskModelPtr struct {
+	HclType               string
+	HclObjectName         string
+	App                   *string
+	Name                  *string
+	Command               *string
+	Memory                *string
+	DiskQuota             *string
+	LogRateLimitPerSecond *string
+	Triggers              *string
+	Labels                *string
+	Annotations           *string
+}
+
+func hclAppTask(atmp *AppTaskModelPtr) string {
+	if atmp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_app_task" {{.HclObjectName}} {
+			{{- if .App}}
+				app = "{{.App}}"
+			{{- end -}}
+			{{if .Name}}
+				name = "{{.Name}}"
+			{{- end -}}
+			{{if .Command}}
+				command = "{{.Command}}"
+			{{- end -}}
+			{{if .Memory}}
+				memory = "{{.Memory}}"
+			{{- end -}}
+			{{if .DiskQuota}}
+				disk_quota = "{{.DiskQuota}}"
+			{{- end -}}
+			{{if .LogRateLimitPerSecond}}
+				log_rate_limit_per_second = "{{.LogRateLimitPerSecond}}"
+			{{- end -}}
+			{{if .Triggers}}
+				triggers = {{.Triggers}}
+			{{- end -}}
+			{{if .Labels}}
+				labels = {{.Labels}}
+			{{- end -}}
+			{{if .Annotations}}
+				annotations = {{.Annotations}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_app_task").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, atmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return atmp.HclType + ` "cloudfoundry_app_task" ` + atmp.HclObjectName + ` {}`
+}
+
+func TestAppTaskResource_Configure(t *testing.T) {
+	var (
+		resourceName   = "cloudfoundry_app_task.rs"
+		testAppGUID    = "e04e63c1-6e69-4537-b9e2-95ab6f3ebfcf"
+		invalidAppGUID = "00000000-0000-0000-0000-000000000000"
+		taskName       = "migrate"
+		taskCommand    = "bin/rake db:migrate"
+		failingCommand = "echo failing && exit 1"
+		createTriggers = `{ schema = "1" }`
+		updateTriggers = `{ schema = "2" }`
+	)
+	t.Parallel()
+	t.Run("happy path - run/update/re-run app task", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_task_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppTask(&AppTaskModelPtr{
+						HclType:               hclObjectResource,
+						HclObjectName:         "rs",
+						App:                   &testAppGUID,
+						Name:                  &taskName,
+						Command:               &taskCommand,
+						Memory:                new("512M"),
+						DiskQuota:             new("1G"),
+						LogRateLimitPerSecond: new("16K"),
+						Triggers:              &createTriggers,
+						Labels:                &testCreateLabel,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestMatchResourceAttr(resourceName, "droplet_guid", regexpValidUUID),
+						resource.TestMatchResourceAttr(resourceName, "created_at", regexpValidRFC3999Format),
+						resource.TestCheckResourceAttr(resourceName, "name", taskName),
+						resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+						resource.TestCheckResourceAttr(resourceName, "sequence_id", "1"),
+						resource.TestCheckResourceAttr(resourceName, "memory", "512M"),
+						resource.TestCheckResourceAttr(resourceName, "disk_quota", "1G"),
+						resource.TestCheckResourceAttr(resourceName, "log_rate_limit_per_second", "16K"),
+						resource.TestCheckNoResourceAttr(resourceName, "failure_reason"),
+						resource.TestCheckResourceAttr(resourceName, "labels.purpose", "testing"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppTask(&AppTaskModelPtr{
+						HclType:               hclObjectResource,
+						HclObjectName:         "rs",
+						App:                   &testAppGUID,
+						Name:                  &taskName,
+						Command:               &taskCommand,
+						Memory:                new("512M"),
+						DiskQuota:             new("1G"),
+						LogRateLimitPerSecond: new("16K"),
+						Triggers:              &createTriggers,
+						Labels:                &testUpdateLabel,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "sequence_id", "1"),
+						resource.TestCheckResourceAttr(resourceName, "labels.purpose", "production"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclAppTask(&AppTaskModelPtr{
+						HclType:               hclObjectResource,
+						HclObjectName:         "rs",
+						App:                   &testAppGUID,
+						Name:                  &taskName,
+						Command:               &taskCommand,
+						Memory:                new("512M"),
+						DiskQuota:             new("1G"),
+						LogRateLimitPerSecond: new("16K"),
+						Triggers:              &updateTriggers,
+						Labels:                &testUpdateLabel,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+						resource.TestCheckResourceAttr(resourceName, "sequence_id", "2"),
+						resource.TestCheckResourceAttr(resourceName, "triggers.schema", "2"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - app task fails", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_task_failed")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppTask(&AppTaskModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &testAppGUID,
+						Command:       &failingCommand,
+					}),
+					ExpectError: regexp.MustCompile(`App Task Failed`),
+				},
+			},
+		})
+	})
+	t.Run("error path - run task for non-existent app", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_task_invalid_app")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclAppTask(&AppTaskModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						App:           &invalidAppGUID,
+						Command:       &taskCommand,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Creating App Task`),
+				},
+			},
+		})
+	})
+}
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAppResource_Configure(t *testing.T) {
@@ -290,4 +294,496 @@ resource "cloudfoundry_app" "app" {
 			},
 		})
 	})
+	t.Run("happy path - update app with canary strategy", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_canary")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-canary"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "1234567890"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "canary"
+	app_deployed_running_check_interval  = 1
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "name", "cf-nodejs-canary"),
+						resource.TestCheckResourceAttr(resourceName, "strategy", "canary"),
+						resource.TestCheckNoResourceAttr(resourceName, "canary_steps"),
+						resource.TestCheckNoResourceAttr(resourceName, "deployment_id"),
+						resource.TestMatchResourceAttr(resourceName, "current_revision_id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "1"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-canary"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "canary"
+	app_deployed_running_check_interval  = 1
+	canary_steps = [
+		{
+			instance_weight = 50
+			pause           = "1s"
+		},
+		{
+			instance_weight = 100
+		}
+	]
+	environment = {
+		MY_ENV = "canary",
+	}
+}
+					`,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("deployment_id")),
+							plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("current_revision_id")),
+						},
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "strategy", "canary"),
+						resource.TestCheckResourceAttr(resourceName, "canary_steps.#", "2"),
+						resource.TestCheckResourceAttr(resourceName, "canary_steps.0.instance_weight", "50"),
+						resource.TestCheckResourceAttr(resourceName, "canary_steps.0.pause", "1s"),
+						resource.TestCheckResourceAttr(resourceName, "canary_steps.1.instance_weight", "100"),
+						resource.TestCheckResourceAttr(resourceName, "environment.MY_ENV", "canary"),
+						resource.TestMatchResourceAttr(resourceName, "deployment_id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "deployment_status", "FINALIZED"),
+						resource.TestCheckResourceAttr(resourceName, "deployment_reason", "DEPLOYED"),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "2"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - canary deployment with crashing instances is canceled", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_canary_crash")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-canary-crash"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "1234567890"
+	instances                            = 1
+	strategy                             = "canary"
+	app_deployed_running_check_interval  = 1
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "strategy", "canary"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-canary-crash"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	instances                            = 1
+	command                              = "npm run crash"
+	strategy                             = "canary"
+	app_deployed_running_check_interval  = 1
+	canary_steps = [
+		{
+			instance_weight = 100
+		}
+	]
+}
+					`,
+					ExpectError: regexp.MustCompile(`deployment was canceled`),
+				},
+			},
+		})
+	})
+	t.Run("happy path - update app with rolling strategy and max in flight", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_rolling_max_in_flight")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-rolling"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "1234567890"
+	memory                               = "256M"
+	instances                            = 3
+	strategy                             = "rolling"
+	max_in_flight                        = 2
+	app_deployed_running_check_interval  = 1
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "max_in_flight", "2"),
+						resource.TestCheckNoResourceAttr(resourceName, "deployment_id"),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "1"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-rolling"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	memory                               = "256M"
+	instances                            = 3
+	strategy                             = "rolling"
+	max_in_flight                        = 2
+	app_deployed_running_check_interval  = 1
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "max_in_flight", "2"),
+						resource.TestMatchResourceAttr(resourceName, "deployment_id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "deployment_status", "FINALIZED"),
+						resource.TestCheckResourceAttr(resourceName, "deployment_reason", "DEPLOYED"),
+						resource.TestMatchResourceAttr(resourceName, "current_revision_id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "2"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("happy path - roll back app to a pinned revision", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_revision")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-revision"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "1234567890"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "rolling"
+	max_in_flight                        = 1
+	app_deployed_running_check_interval  = 1
+	environment = {
+		MY_ENV = "v1",
+	}
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr(resourceName, "revision"),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "1"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-revision"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "rolling"
+	max_in_flight                        = 1
+	app_deployed_running_check_interval  = 1
+	environment = {
+		MY_ENV = "v2",
+	}
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "environment.MY_ENV", "v2"),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "2"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-revision"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "rolling"
+	max_in_flight                        = 1
+	app_deployed_running_check_interval  = 1
+	revision                             = "1"
+	environment = {
+		MY_ENV = "v1",
+	}
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+						resource.TestCheckResourceAttr(resourceName, "environment.MY_ENV", "v1"),
+						resource.TestCheckResourceAttr(resourceName, "deployment_reason", "DEPLOYED"),
+						resource.TestCheckResourceAttr(resourceName, "current_revision_version", "3"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-revision"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "999999"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "rolling"
+	max_in_flight                        = 1
+	app_deployed_running_check_interval  = 1
+	revision                             = "42"
+	environment = {
+		MY_ENV = "v1",
+	}
+}
+					`,
+					ExpectError: regexp.MustCompile(`could not find revision 42`),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name                                 = "cf-nodejs-revision"
+	space_name                           = "tf-space-1"
+	org_name                             = "PerformanceTeamBLR"
+	path                                 = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash                     = "555555"
+	memory                               = "256M"
+	instances                            = 2
+	strategy                             = "rolling"
+	max_in_flight                        = 1
+	app_deployed_running_check_interval  = 1
+	revision                             = "1"
+	environment = {
+		MY_ENV = "v1",
+	}
+}
+					`,
+					ExpectError: regexp.MustCompile(`source_code_hash cannot be changed while revision is set`),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid revision", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name       = "cf-nodejs"
+	space_name = "tf-space-1"
+	org_name   = "PerformanceTeamBLR"
+	path       = "../../assets/cf-sample-app-nodejs.zip"
+	revision   = "latest"
+}
+					`,
+					ExpectError: regexp.MustCompile(`must be a revision version`),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name       = "cf-nodejs"
+	space_name = "tf-space-1"
+	org_name   = "PerformanceTeamBLR"
+	path       = "../../assets/cf-sample-app-nodejs.zip"
+	strategy   = "blue-green"
+	revision   = "1"
+}
+					`,
+					ExpectError: regexp.MustCompile(`revision cannot be set when strategy is blue-green`),
+				},
+			},
+		})
+	})
+	t.Run("happy path - create app with cnb lifecycle", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_app_cnb")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name             = "cf-nodejs-cnb"
+	space_name       = "tf-space-1"
+	org_name         = "PerformanceTeamBLR"
+	path             = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash = "1234567890"
+	memory           = "256M"
+	lifecycle_type   = "cnb"
+	buildpacks       = ["docker://registry.private.example.com/paketo-buildpacks/nodejs"]
+	cnb_credentials = {
+		"registry.private.example.com" = {
+			username = "admin"
+			password = "secret"
+		}
+	}
+}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "lifecycle_type", "cnb"),
+						resource.TestCheckResourceAttr(resourceName, "buildpacks.0", "docker://registry.private.example.com/paketo-buildpacks/nodejs"),
+						resource.TestCheckResourceAttr(resourceName, "cnb_credentials.registry.private.example.com.username", "admin"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name             = "cf-nodejs-cnb"
+	space_name       = "tf-space-1"
+	org_name         = "PerformanceTeamBLR"
+	path             = "../../assets/cf-sample-app-nodejs.zip"
+	source_code_hash = "999999"
+	memory           = "512M"
+	lifecycle_type   = "cnb"
+	buildpacks       = ["docker://registry.private.example.com/paketo-buildpacks/nodejs"]
+	cnb_credentials = {
+		"registry.private.example.com" = {
+			token = "secret-token"
+		}
+	}
+}
+					`,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("deployment_id"), knownvalue.Null()),
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("deployment_status"), knownvalue.Null()),
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("current_revision_id"), knownvalue.Null()),
+						},
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "lifecycle_type", "cnb"),
+						resource.TestCheckResourceAttr(resourceName, "memory", "512M"),
+						resource.TestCheckResourceAttr(resourceName, "cnb_credentials.registry.private.example.com.token", "secret-token"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid lifecycle combinations", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name       = "cf-nodejs"
+	space_name = "tf-space-1"
+	org_name   = "PerformanceTeamBLR"
+	path       = "../../assets/cf-sample-app-nodejs.zip"
+	lifecycle_type = "buildpack"
+	cnb_credentials = {
+		"registry.example.com" = {
+			token = "secret-token"
+		}
+	}
+}
+					`,
+					ExpectError: regexp.MustCompile(`cnb_credentials can only be set when lifecycle_type is cnb`),
+				},
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name       = "cf-nodejs"
+	space_name = "tf-space-1"
+	org_name   = "PerformanceTeamBLR"
+	path       = "../../assets/cf-sample-app-nodejs.zip"
+	lifecycle_type = "docker"
+}
+					`,
+					ExpectError: regexp.MustCompile(`docker_image must be set when lifecycle_type is docker`),
+				},
+			},
+		})
+	})
+	t.Run("error path - max in flight without rolling or canary strategy", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name          = "cf-nodejs"
+	space_name    = "tf-space-1"
+	org_name      = "PerformanceTeamBLR"
+	path          = "../../assets/cf-sample-app-nodejs.zip"
+	strategy      = "blue-green"
+	max_in_flight = 2
+}
+					`,
+					ExpectError: regexp.MustCompile(`max_in_flight can only be set when strategy is rolling or canary`),
+				},
+			},
+		})
+	})
+	t.Run("error path - canary steps without canary strategy", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + `
+resource "cloudfoundry_app" "app" {
+	name       = "cf-nodejs"
+	space_name = "tf-space-1"
+	org_name   = "PerformanceTeamBLR"
+	path       = "../../assets/cf-sample-app-nodejs.zip"
+	strategy   = "rolling"
+	canary_steps = [
+		{
+			instance_weight = 50
+		}
+	]
+}
+					`,
+					ExpectError: regexp.MustCompile(`canary_steps can only be set when strategy is canary`),
+				},
+			},
+		})
+	})
 }
@@ -8,6 +8,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -96,6 +97,11 @@ func (r *BuildpackResource) Schema(ctx context.Context, req resource.SchemaReque
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "buildpack", timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -138,6 +144,12 @@ func (r *BuildpackResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createBuildpack, diags := plan.mapCreateBuildpackTypeToValues(ctx)
 	resp.Diagnostics.Append(diags...)
 
@@ -167,7 +179,7 @@ func (r *BuildpackResource) Create(ctx context.Context, req resource.CreateReque
 			)
 		}
 		if jobID != "" {
-			if err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+			if err = pollJob(ctx, *r.cfClient, jobID, createTimeout); err != nil {
 				resp.Diagnostics.AddError(
 					"API Error Uploading Buildpack",
 					"Failed in uploading the Buildpack with file "+fileName+" : "+err.Error(),
@@ -187,6 +199,7 @@ func (r *BuildpackResource) Create(ctx context.Context, req resource.CreateReque
 	resp.Diagnostics.Append(diags...)
 	data.Path = plan.Path
 	data.SourceCodeHash = plan.SourceCodeHash
+	data.Timeouts = plan.Timeouts
 
 	tflog.Trace(ctx, "created a buildpack resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -218,6 +231,7 @@ func (rs *BuildpackResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(diags...)
 	state.Path = data.Path
 	state.SourceCodeHash = data.SourceCodeHash
+	state.Timeouts = data.Timeouts
 
 	tflog.Trace(ctx, "read a buildpack resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -243,6 +257,12 @@ func (rs *BuildpackResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateBuildpack, diags := plan.mapUpdateBuildpackTypeToValues(ctx, previousState)
 	resp.Diagnostics.Append(diags...)
 
@@ -273,7 +293,7 @@ func (rs *BuildpackResource) Update(ctx context.Context, req resource.UpdateRequ
 			)
 			return
 		}
-		if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+		if err = pollJob(ctx, *rs.cfClient, jobID, updateTimeout); err != nil {
 			resp.Diagnostics.AddError(
 				"API Error Uploading Buildpack",
 				"Failed in uploading the Buildpack with file "+fileName+" : "+err.Error(),
@@ -292,6 +312,7 @@ func (rs *BuildpackResource) Update(ctx context.Context, req resource.UpdateRequ
 	resp.Diagnostics.Append(diags...)
 	data.Path = plan.Path
 	data.SourceCodeHash = plan.SourceCodeHash
+	data.Timeouts = plan.Timeouts
 
 	tflog.Trace(ctx, "updated a buildpack resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -315,6 +336,12 @@ func (rs *BuildpackResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Buildpacks.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -324,7 +351,7 @@ func (rs *BuildpackResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Buildpack",
 			"Failed in deleting the Buildpack with ID "+state.Id.ValueString()+" and name "+state.Name.ValueString()+" : "+err.Error(),
@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -113,6 +114,9 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "domain", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -145,7 +149,7 @@ func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
-	var plan domainType
+	var plan domainResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -167,14 +171,16 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	plan, diags = mapDomainValuesToType(ctx, domain)
+	data, diags := mapDomainValuesToType(ctx, domain)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a domain resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := domainResouerceIdentityModel{
-		DomainGUID: types.StringValue(plan.Id.ValueString()),
+		DomainGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -182,7 +188,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (rs *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data domainType
+	var data domainResourceType
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -196,12 +202,14 @@ func (rs *DomainResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	data, diags = mapDomainValuesToType(ctx, domain)
+	domainData, diags := mapDomainValuesToType(ctx, domain)
 	resp.Diagnostics.Append(diags...)
 
+	state := domainData.Expand()
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a domain resource")
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity domainResouerceIdentityModel
 
@@ -217,7 +225,7 @@ func (rs *DomainResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (rs *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState domainType
+	var plan, previousState domainResourceType
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
 	if resp.Diagnostics.HasError() {
@@ -270,8 +278,10 @@ func (rs *DomainResource) Update(ctx context.Context, req resource.UpdateRequest
 	data, diags := mapDomainValuesToType(ctx, domain)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "updated a domain resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
@@ -286,7 +296,7 @@ func (rs *DomainResource) Update(ctx context.Context, req resource.UpdateRequest
 
 func (rs *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 
-	var state domainType
+	var state domainResourceType
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -294,6 +304,12 @@ func (rs *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Domains.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -303,7 +319,7 @@ func (rs *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Domain",
 			"Failed in deleting the domain with ID "+state.Id.ValueString()+" and name "+state.Name.ValueString()+": "+err.Error(),
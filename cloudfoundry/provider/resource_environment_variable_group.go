@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	environmentVariableGroupRunning = "running"
+	environmentVariableGroupStaging = "staging"
+)
+
+var (
+	_ resource.Resource                = &environmentVariableGroupResource{}
+	_ resource.ResourceWithConfigure   = &environmentVariableGroupResource{}
+	_ resource.ResourceWithImportState = &environmentVariableGroupResource{}
+)
+
+// Instantiates an environment variable group resource.
+func NewEnvironmentVariableGroupResource() resource.Resource {
+	return &environmentVariableGroupResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type environmentVariableGroupResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *environmentVariableGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable_group"
+}
+
+func (r *environmentVariableGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages variables of the running or staging environment variable group, which are injected into all running apps or all staging apps respectively.
+		Only the variables configured in the resource are managed, other variables of the group are left untouched. Destroying the resource removes the managed variables from the group.
+
+__Note:__ Only admin users can update environment variable groups. Apps pick up changes of the running group only after a restart.`,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The environment variable group, either 'running' or 'staging'.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentVariableGroupRunning, environmentVariableGroupStaging),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "The variables of the group managed by Terraform.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The name of the environment variable group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			updatedAtKey: updatedAtSchema(),
+		},
+	}
+}
+
+func (r *environmentVariableGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *environmentVariableGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan environmentVariableGroupType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := setEnvForUpdate(ctx, types.MapNull(types.StringType), plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.updateEnvironmentVariableGroup(ctx, plan.Type.ValueString(), variables)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Environment Variable Group",
+			"Could not set variables of the "+plan.Type.ValueString()+" environment variable group : "+err.Error(),
+		)
+		return
+	}
+
+	data, diags := mapEnvironmentVariableGroupValuesToType(ctx, group, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created an environment variable group resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *environmentVariableGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data environmentVariableGroupType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.cfClient.EnvVarGroups.Get(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "environment variable group", data.Id.ValueString())
+		return
+	}
+
+	data, diags = mapEnvironmentVariableGroupValuesToType(ctx, group, data.Variables)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "read an environment variable group resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *environmentVariableGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, previousState environmentVariableGroupType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := setEnvForUpdate(ctx, previousState.Variables, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.updateEnvironmentVariableGroup(ctx, plan.Type.ValueString(), variables)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Environment Variable Group",
+			"Could not update variables of the "+plan.Type.ValueString()+" environment variable group : "+err.Error(),
+		)
+		return
+	}
+
+	data, diags := mapEnvironmentVariableGroupValuesToType(ctx, group, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated an environment variable group resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *environmentVariableGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state environmentVariableGroupType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group itself cannot be deleted, only the managed variables are removed from it.
+	variables, diags := setEnvForUpdate(ctx, state.Variables, types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.updateEnvironmentVariableGroup(ctx, state.Type.ValueString(), variables)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Environment Variable Group",
+			"Could not remove variables from the "+state.Type.ValueString()+" environment variable group : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an environment variable group resource")
+}
+
+func (r *environmentVariableGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Merges the given variables into the environment variable group.
+// The update of the cf-client cannot remove variables, hence the request is sent directly.
+func (r *environmentVariableGroupResource) updateEnvironmentVariableGroup(ctx context.Context, name string, variables map[string]*string) (*cfv3resource.EnvVarGroup, error) {
+	body, err := json.Marshal(environmentVariableGroupUpdate{Var: variables})
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPatch, r.cfClient.ApiURL("/v3/environment_variable_groups/"+name), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.cfClient.ExecuteAuthRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var group cfv3resource.EnvVarGroup
+	if err = json.NewDecoder(httpResp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("error decoding environment variable group response: %w", err)
+	}
+	return &group, nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type EnvironmentVariableGroupModelPtr struct {
+	HclType       string
+	HclObjectName string
+	Type          *string
+	Variables     *string
+}
+
+func hclEnvironmentVariableGroup(evgmp *EnvironmentVariableGroupModelPtr) string {
+	if evgmp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_environment_variable_group" {{.HclObjectName}} {
+			{{- if .Type}}
+				type = "{{.Type}}"
+			{{- end -}}
+			{{if .Variables}}
+				variables = {{.Variables}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_environment_variable_group").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, evgmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return evgmp.HclType + ` "cloudfoundry_environment_variable_group" ` + evgmp.HclObjectName + ` {}`
+}
+
+func TestEnvironmentVariableGroupResource_Configure(t *testing.T) {
+	var (
+		resourceName    = "cloudfoundry_environment_variable_group.rs"
+		createVariables = `{ NO_PROXY = "localhost", SSL_CERT_DIR = "/etc/ssl/certs" }`
+		updateVariables = `{ NO_PROXY = "localhost,.internal" }`
+	)
+	t.Parallel()
+	t.Run("happy path - create/update/delete running environment variable group", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_environment_variable_group_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEnvironmentVariableGroup(&EnvironmentVariableGroupModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Type:          new("running"),
+						Variables:     &createVariables,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", "running"),
+						resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+						resource.TestCheckResourceAttr(resourceName, "variables.NO_PROXY", "localhost"),
+						resource.TestCheckNoResourceAttr(resourceName, "variables.HTTP_PROXY"),
+						resource.TestMatchResourceAttr(resourceName, "updated_at", regexpValidRFC3999Format),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclEnvironmentVariableGroup(&EnvironmentVariableGroupModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Type:          new("running"),
+						Variables:     &updateVariables,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "variables.%", "1"),
+						resource.TestCheckResourceAttr(resourceName, "variables.NO_PROXY", "localhost,.internal"),
+						resource.TestCheckNoResourceAttr(resourceName, "variables.SSL_CERT_DIR"),
+					),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid group type", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclEnvironmentVariableGroup(&EnvironmentVariableGroupModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Type:          new("building"),
+						Variables:     &createVariables,
+					}),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &featureFlagResource{}
+	_ resource.ResourceWithConfigure   = &featureFlagResource{}
+	_ resource.ResourceWithImportState = &featureFlagResource{}
+	_ resource.ResourceWithIdentity    = &featureFlagResource{}
+)
+
+// Instantiates a feature flag resource.
+func NewFeatureFlagResource() resource.Resource {
+	return &featureFlagResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type featureFlagResource struct {
+	cfClient *cfv3client.Client
+}
+
+type featureFlagResourceIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *featureFlagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag"
+}
+
+func (r *featureFlagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a global Cloud Foundry feature flag. Feature flags always exist on the platform, so creating the resource takes over the flag and destroying it restores the default value of the flag. Flags whose default value is not known to the provider keep their value.
+
+__Note:__ Only admin users can update feature flags.`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feature flag e.g. diego_docker or user_org_creation.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9_]+$`), "must only contain lowercase letters, digits and underscores"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feature flag is enabled.",
+				Required:            true,
+			},
+			"custom_error_message": schema.StringAttribute{
+				MarkdownDescription: "The error message returned by the API when a user performs an action which is disabled by the feature flag.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The name of the feature flag.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			updatedAtKey: updatedAtSchema(),
+		},
+	}
+}
+
+func (r *featureFlagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *featureFlagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *featureFlagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan featureFlagType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlag, err := r.updateFeatureFlag(ctx, plan.Name.ValueString(), plan.Enabled.ValueBool(), plan.CustomErrorMessage.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Feature Flag",
+			"Could not update feature flag "+plan.Name.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	data := mapFeatureFlagValuesToType(featureFlag)
+
+	tflog.Trace(ctx, "created a feature flag resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := featureFlagResourceIdentityModel{
+		Name: types.StringValue(data.Name.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *featureFlagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data featureFlagType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name is not yet known when the resource was just imported.
+	featureFlag, err := r.sendFeatureFlagRequest(ctx, http.MethodGet, data.Id.ValueString(), nil)
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "feature flag", data.Id.ValueString())
+		return
+	}
+
+	data = mapFeatureFlagValuesToType(featureFlag)
+
+	tflog.Trace(ctx, "read a feature flag resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := featureFlagResourceIdentityModel{
+		Name: types.StringValue(data.Name.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *featureFlagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan featureFlagType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlag, err := r.updateFeatureFlag(ctx, plan.Name.ValueString(), plan.Enabled.ValueBool(), plan.CustomErrorMessage.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Feature Flag",
+			"Could not update feature flag "+plan.Name.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	data := mapFeatureFlagValuesToType(featureFlag)
+
+	tflog.Trace(ctx, "updated a feature flag resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := featureFlagResourceIdentityModel{
+		Name: types.StringValue(data.Name.ValueString()),
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *featureFlagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state featureFlagType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Feature flags cannot be deleted, the default value of the flag is restored instead.
+	enabled, ok := featureFlagDefaults[state.Name.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unknown Default of Feature Flag",
+			"The default value of feature flag "+state.Name.ValueString()+" is not known to the provider, the flag keeps its current value.",
+		)
+		return
+	}
+	_, err := r.updateFeatureFlag(ctx, state.Name.ValueString(), enabled, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Resetting Feature Flag",
+			"Could not restore the default of feature flag "+state.Name.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a feature flag resource")
+}
+
+// Sets the given value and error message of a feature flag, an empty message removes the custom error message.
+func (r *featureFlagResource) updateFeatureFlag(ctx context.Context, name string, enabled bool, customErrorMessage string) (*cfv3resource.FeatureFlag, error) {
+	update := cfv3resource.NewFeatureFlagUpdate().
+		WithEnabled(enabled).
+		WithCustomErrorMessage(customErrorMessage)
+	body, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+	return r.sendFeatureFlagRequest(ctx, http.MethodPatch, name, bytes.NewReader(body))
+}
+
+// Sends a request for the feature flag with the given name. The cf-client only knows a fixed set of feature flags,
+// hence the request is sent directly so that flags added to Cloud Foundry later, e.g. diego_cnb, can be managed.
+func (r *featureFlagResource) sendFeatureFlagRequest(ctx context.Context, method string, name string, body io.Reader) (*cfv3resource.FeatureFlag, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, r.cfClient.ApiURL("/v3/feature_flags/"+name), body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := r.cfClient.ExecuteAuthRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var featureFlag cfv3resource.FeatureFlag
+	if err = json.NewDecoder(httpResp.Body).Decode(&featureFlag); err != nil {
+		return nil, fmt.Errorf("error decoding feature flag response: %w", err)
+	}
+	return &featureFlag, nil
+}
+
+func (r *featureFlagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("name"), req, resp)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type FeatureFlagModelPtr struct {
+	HclType            string
+	HclObjectName      string
+	Name               *string
+	Enabled            *bool
+	CustomErrorMessage *string
+}
+
+func hclFeatureFlag(ffmp *FeatureFlagModelPtr) string {
+	if ffmp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_feature_flag" {{.HclObjectName}} {
+			{{- if .Name}}
+				name = "{{.Name}}"
+			{{- end -}}
+			{{if .Enabled}}
+				enabled = {{.Enabled}}
+			{{- end -}}
+			{{if .CustomErrorMessage}}
+				custom_error_message = "{{.CustomErrorMessage}}"
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_feature_flag").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, ffmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return ffmp.HclType + ` "cloudfoundry_feature_flag" ` + ffmp.HclObjectName + ` {}`
+}
+
+func TestFeatureFlagResource_Configure(t *testing.T) {
+	var (
+		resourceName = "cloudfoundry_feature_flag.rs"
+		flagName     = "user_org_creation"
+		errorMessage = "Please request an org through the self-service portal"
+	)
+	t.Parallel()
+	t.Run("happy path - update/reset feature flag", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_feature_flag_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclFeatureFlag(&FeatureFlagModelPtr{
+						HclType:            hclObjectResource,
+						HclObjectName:      "rs",
+						Name:               &flagName,
+						Enabled:            new(true),
+						CustomErrorMessage: &errorMessage,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", flagName),
+						resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+						resource.TestCheckResourceAttr(resourceName, "custom_error_message", errorMessage),
+						resource.TestMatchResourceAttr(resourceName, "updated_at", regexpValidRFC3999Format),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclFeatureFlag(&FeatureFlagModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Name:          &flagName,
+						Enabled:       new(false),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+						resource.TestCheckNoResourceAttr(resourceName, "custom_error_message"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportStateId:     flagName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+	t.Run("happy path - update feature flag unknown to the cf-client", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_feature_flag_diego_cnb")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclFeatureFlag(&FeatureFlagModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Name:          new("diego_cnb"),
+						Enabled:       new(true),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", "diego_cnb"),
+						resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportStateId:     "diego_cnb",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+	t.Run("error path - unknown feature flag", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_feature_flag_unknown")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclFeatureFlag(&FeatureFlagModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Name:          new("unknown_flag"),
+						Enabled:       new(true),
+					}),
+					ExpectError: regexp.MustCompile(`API Error Updating Feature Flag`),
+				},
+			},
+		})
+	})
+	t.Run("error path - invalid feature flag name", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclFeatureFlag(&FeatureFlagModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Name:          new("Diego Docker"),
+						Enabled:       new(true),
+					}),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				},
+			},
+		})
+	})
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/mta"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -51,7 +53,7 @@ func (r *mtaResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_mta"
 }
 
-func (r *mtaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *mtaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Allows deploying applications and services via an MTAR archive or URL.
 		
@@ -215,6 +217,11 @@ __Note:__
 					},
 				},
 			},
+			"timeouts": timeoutsSchemaWithDefault(ctx, "MTA deployment", timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}, defaultMtaTimeout),
 		},
 	}
 }
@@ -283,6 +290,17 @@ func (r *mtaResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 		return
 	}
 
+	var timeout time.Duration
+	if reqState == nil {
+		timeout, diags = mtarType.Timeouts.Create(ctx, defaultMtaTimeout)
+	} else {
+		timeout, diags = mtarType.Timeouts.Update(ctx, defaultMtaTimeout)
+	}
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+
 	spaceGuid := mtarType.Space.ValueString()
 	namespace := mtarType.Namespace.ValueString()
 
@@ -325,7 +343,7 @@ func (r *mtaResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 			return
 		}
 
-		jobResponse, err := mta.PollMtaJob(ctx, r.mtaClient, spaceGuid, uploadJobID, mta.FinishedState, uploadResp.Header.Get("x-cf-app-instance"), namespace)
+		jobResponse, err := mta.PollMtaJob(ctx, r.mtaClient, spaceGuid, uploadJobID, mta.FinishedState, uploadResp.Header.Get("x-cf-app-instance"), namespace, timeout)
 		if err != nil {
 			respDiags.AddError(
 				"Unable to poll MTAR upload job",
@@ -405,7 +423,7 @@ func (r *mtaResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 	}
 
 	// Check for an ongoing operation for this MTA ID and abort it
-	_, err = mta.CheckOngoingOperation(ctx, r.mtaClient, mtaId, uploadedFile.Namespace, spaceGuid)
+	_, err = mta.CheckOngoingOperation(ctx, r.mtaClient, mtaId, uploadedFile.Namespace, spaceGuid, timeout)
 	if err != nil {
 		respDiags.AddError(
 			"Unable to check for and abort ongoing MTA operation",
@@ -457,7 +475,7 @@ func (r *mtaResource) upsert(ctx context.Context, reqPlan *tfsdk.Plan, reqState
 		return
 	}
 
-	messages, err := mta.PollMtaOperation(ctx, r.mtaClient, spaceGuid, operationId, mta.FinishedState)
+	messages, err := mta.PollMtaOperation(ctx, r.mtaClient, spaceGuid, operationId, mta.FinishedState, timeout)
 	tflog.Info(ctx, messages)
 	if err != nil {
 		respDiags.AddError(
@@ -564,6 +582,12 @@ func (r *mtaResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := mtarType.Timeouts.Delete(ctx, defaultMtaTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mtaId := mtarType.Id.ValueString()
 	spaceGuid := mtarType.Space.ValueString()
 
@@ -572,7 +596,7 @@ func (r *mtaResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Check for an ongoing operation for this MTA ID and abort it
-	_, err := mta.CheckOngoingOperation(ctx, r.mtaClient, mtaId, mtarType.Namespace.ValueString(), spaceGuid)
+	_, err := mta.CheckOngoingOperation(ctx, r.mtaClient, mtaId, mtarType.Namespace.ValueString(), spaceGuid, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to check for and abort ongoing MTA operation",
@@ -599,7 +623,7 @@ func (r *mtaResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	messages, err := mta.PollMtaOperation(ctx, r.mtaClient, spaceGuid, operationId, mta.FinishedState)
+	messages, err := mta.PollMtaOperation(ctx, r.mtaClient, spaceGuid, operationId, mta.FinishedState, deleteTimeout)
 	tflog.Info(ctx, messages)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure   = &orgResource{}
 	_ resource.ResourceWithImportState = &orgResource{}
 	_ resource.ResourceWithIdentity    = &orgResource{}
+	_ resource.ResourceWithMoveState   = &orgResource{}
 )
 
 // NewOrgResource is a helper function to simplify the provider implementation.
@@ -105,6 +107,9 @@ func (r *orgResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "The ID of quota to be applied to this Org. Default quota is assigned to the org by default.",
 				Computed:            true,
 			},
+			"timeouts": timeoutsSchema(ctx, "organization", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 
@@ -123,7 +128,7 @@ func (rs *orgResource) IdentitySchema(_ context.Context, _ resource.IdentitySche
 // Create creates the resource and sets the initial Terraform state.
 func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
-	var plan orgType
+	var plan orgResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -153,13 +158,15 @@ func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	plan, diags = mapOrgValuesToType(ctx, org)
+	data, diags := mapOrgValuesToType(ctx, org)
 	resp.Diagnostics.Append(diags...)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := OrgResouerceIdentityModel{
-		OrgGUID: types.StringValue(plan.ID.ValueString()),
+		OrgGUID: types.StringValue(state.ID.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -168,7 +175,7 @@ func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data orgType
+	var data orgResourceType
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -196,10 +203,12 @@ func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		)
 		return
 	}
-	data, diags = mapOrgValuesToType(ctx, org)
+	orgData, diags := mapOrgValuesToType(ctx, org)
 	resp.Diagnostics.Append(diags...)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	state := orgData.Expand()
+	state.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity OrgResouerceIdentityModel
 
@@ -216,7 +225,7 @@ func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState orgType
+	var plan, previousState orgResourceType
 	var diags diag.Diagnostics
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
@@ -254,10 +263,12 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	plan, diags = mapOrgValuesToType(ctx, org)
+	data, diags := mapOrgValuesToType(ctx, org)
 	resp.Diagnostics.Append(diags...)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
@@ -272,13 +283,20 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete the resource and removes the Terraform state on success.
 func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state orgType
+	var state orgResourceType
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := r.cfClient.Organizations.Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -288,7 +306,7 @@ func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	if err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *r.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"Delete org failed",
 			"Failed in Deleting organization "+state.ID.ValueString()+": "+err.Error(),
@@ -306,3 +324,9 @@ func (r *orgResource) ImportState(ctx context.Context, req resource.ImportStateR
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("org_guid"), req, resp)
 }
+
+func (r *orgResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_org", nil),
+	}
+}
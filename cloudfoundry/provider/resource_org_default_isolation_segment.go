@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &orgDefaultIsolationSegmentResource{}
+	_ resource.ResourceWithConfigure   = &orgDefaultIsolationSegmentResource{}
+	_ resource.ResourceWithImportState = &orgDefaultIsolationSegmentResource{}
+)
+
+// Instantiates an org default isolation segment resource.
+func NewOrgDefaultIsolationSegmentResource() resource.Resource {
+	return &orgDefaultIsolationSegmentResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type orgDefaultIsolationSegmentResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *orgDefaultIsolationSegmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_default_isolation_segment"
+}
+
+func (r *orgDefaultIsolationSegmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Assigns the default isolation segment of an organization. The isolation segment must be entitled to the organization e.g. with the ` + "`cloudfoundry_isolation_segment_entitlement`" + ` resource. Destroying the resource resets the default to the shared isolation segment.
+
+Running apps only move to the new isolation segment after they are restarted, the started apps of the spaces without an own isolation segment are therefore listed in ` + "`affected_apps`" + `.
+
+__Note:__ Do not set ` + "`default`" + ` of the ` + "`cloudfoundry_isolation_segment_entitlement`" + ` resource for the same organization when using this resource.`,
+		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"segment": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the isolation segment to use as default for the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"affected_apps": schema.SetAttribute{
+				MarkdownDescription: "The GUIDs of the apps which were started when the isolation segment was last assigned and must be restarted to run in it. Empty after an import.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *orgDefaultIsolationSegmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *orgDefaultIsolationSegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan orgDefaultIsolationSegmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.assignDefaultIsolationSegment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created an org default isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgDefaultIsolationSegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data orgDefaultIsolationSegmentType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, err := r.cfClient.Organizations.GetDefaultIsolationSegment(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "org default isolation segment", data.Id.ValueString())
+		return
+	}
+	if segment == "" {
+		resp.Diagnostics.AddWarning(
+			"Default Isolation Segment Removed",
+			"The organization "+data.Id.ValueString()+" has no default isolation segment anymore, the resource is removed from the state",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = mapOrgDefaultIsolationSegmentValuesToType(data.Id.ValueString(), segment, data.AffectedApps)
+
+	tflog.Trace(ctx, "read an org default isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgDefaultIsolationSegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan orgDefaultIsolationSegmentType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.assignDefaultIsolationSegment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated an org default isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgDefaultIsolationSegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state orgDefaultIsolationSegmentType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfClient.Organizations.AssignDefaultIsolationSegment(ctx, state.Org.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Resetting Default Isolation Segment",
+			"Could not reset the default isolation segment of org with ID "+state.Org.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an org default isolation segment resource")
+}
+
+func (r *orgDefaultIsolationSegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Assigns the default isolation segment of the org and collects the started apps of the spaces which inherit it.
+func (r *orgDefaultIsolationSegmentResource) assignDefaultIsolationSegment(ctx context.Context, plan orgDefaultIsolationSegmentType) (orgDefaultIsolationSegmentType, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	err := r.cfClient.Organizations.AssignDefaultIsolationSegment(ctx, plan.Org.ValueString(), plan.Segment.ValueString())
+	if err != nil {
+		diagnostics.AddError(
+			"API Error Assigning Default Isolation Segment",
+			"Could not set Isolation Segment with ID "+plan.Segment.ValueString()+" on org with ID "+plan.Org.ValueString()+" : "+err.Error(),
+		)
+		return orgDefaultIsolationSegmentType{}, diagnostics
+	}
+
+	affectedApps, err := r.listInheritingStartedApps(ctx, plan.Org.ValueString())
+	if err != nil {
+		diagnostics.AddError(
+			"API Error Fetching Apps",
+			"Could not list the apps of org with ID "+plan.Org.ValueString()+" : "+err.Error(),
+		)
+		return orgDefaultIsolationSegmentType{}, diagnostics
+	}
+	if len(affectedApps) > 0 {
+		diagnostics.AddWarning(
+			"Apps Must Be Restarted",
+			"The following apps must be restarted to run in the isolation segment with ID "+plan.Segment.ValueString()+" : "+strings.Join(affectedApps, ", "),
+		)
+	}
+
+	affectedAppsSet, diags := types.SetValueFrom(ctx, types.StringType, affectedApps)
+	diagnostics.Append(diags...)
+
+	return mapOrgDefaultIsolationSegmentValuesToType(plan.Org.ValueString(), plan.Segment.ValueString(), affectedAppsSet), diagnostics
+}
+
+// Lists the GUIDs of the started apps of the org in spaces without an isolation segment of their own. The spaces with
+// an explicit segment are read per segment entitled to the org, so the cost does not grow with the number of spaces.
+func (r *orgDefaultIsolationSegmentResource) listInheritingStartedApps(ctx context.Context, orgGUID string) ([]string, error) {
+	segmentOpts := cfv3client.NewIsolationSegmentOptions()
+	segmentOpts.OrganizationGUIDs = cfv3client.Filter{
+		Values: []string{orgGUID},
+	}
+	segments, err := r.cfClient.IsolationSegments.ListAll(ctx, segmentOpts)
+	if err != nil {
+		return nil, err
+	}
+	isolatedSpaces := map[string]bool{}
+	for _, segment := range segments {
+		spaceGUIDs, err := r.cfClient.IsolationSegments.ListSpaceRelationships(ctx, segment.GUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, spaceGUID := range spaceGUIDs {
+			isolatedSpaces[spaceGUID] = true
+		}
+	}
+
+	appOpts := cfv3client.NewAppListOptions()
+	appOpts.OrganizationGUIDs = cfv3client.Filter{
+		Values: []string{orgGUID},
+	}
+	apps, err := r.cfClient.Applications.ListAll(ctx, appOpts)
+	if err != nil {
+		return nil, err
+	}
+	startedApps := []string{}
+	for _, app := range apps {
+		if app.State == "STARTED" && !isolatedSpaces[app.Relationships.Space.Data.GUID] {
+			startedApps = append(startedApps, app.GUID)
+		}
+	}
+	return startedApps, nil
+}
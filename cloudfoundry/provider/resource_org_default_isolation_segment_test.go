@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type OrgDefaultIsolationSegmentModelPtr struct {
+	HclType       string
+	HclObjectName string
+	Org           *string
+	Segment       *string
+}
+
+func hclOrgDefaultIsolationSegment(odismp *OrgDefaultIsolationSegmentModelPtr) string {
+	if odismp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_org_default_isolation_segment" {{.HclObjectName}} {
+			{{- if .Org}}
+				org = "{{.Org}}"
+			{{- end -}}
+			{{if .Segment}}
+				segment = "{{.Segment}}"
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_org_default_isolation_segment").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, odismp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return odismp.HclType + ` "cloudfoundry_org_default_isolation_segment" ` + odismp.HclObjectName + ` {}`
+}
+
+func TestOrgDefaultIsolationSegmentResource_Configure(t *testing.T) {
+	var (
+		resourceName           = "cloudfoundry_org_default_isolation_segment.rs"
+		testOrgGUID            = "784b4cd0-4771-4e4d-9052-a07e178bae56"
+		segmentGUID            = "63ae51b9-9073-4409-81b0-3704b8de85dd"
+		updatedSegmentGUID     = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		notEntitledSegmentGUID = "40b73419-5e01-4be0-baea-932d46cea45b"
+	)
+	t.Parallel()
+	t.Run("happy path - create/update/import org default isolation segment", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_org_default_isolation_segment_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclOrgDefaultIsolationSegment(&OrgDefaultIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Org:           &testOrgGUID,
+						Segment:       &segmentGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", testOrgGUID),
+						resource.TestCheckResourceAttr(resourceName, "segment", segmentGUID),
+						resource.TestCheckResourceAttr(resourceName, "affected_apps.#", "2"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "affected_apps.*", "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclOrgDefaultIsolationSegment(&OrgDefaultIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Org:           &testOrgGUID,
+						Segment:       &updatedSegmentGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "segment", updatedSegmentGUID),
+						resource.TestCheckResourceAttr(resourceName, "affected_apps.#", "2"),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportStateId:           testOrgGUID,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"affected_apps"},
+				},
+			},
+		})
+	})
+	t.Run("error path - assign isolation segment not entitled to the org", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_org_default_isolation_segment_not_entitled")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclOrgDefaultIsolationSegment(&OrgDefaultIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Org:           &testOrgGUID,
+						Segment:       &notEntitledSegmentGUID,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Assigning Default Isolation Segment`),
+				},
+			},
+		})
+	})
+}
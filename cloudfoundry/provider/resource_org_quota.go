@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -40,7 +41,7 @@ func (r *orgQuotaResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_org_quota"
 }
 
-func (r *orgQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *orgQuotaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Provides a Cloud Foundry resource to manage org quota definitions.",
 		Attributes: map[string]schema.Attribute{
@@ -104,6 +105,9 @@ func (r *orgQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			idKey:        guidSchema(),
 			createdAtKey: createdAtSchema(),
 			updatedAtKey: updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "organization quota", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -134,7 +138,7 @@ func (r *orgQuotaResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *orgQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var orgQuotaType OrgQuotaType
+	var orgQuotaType orgQuotaResourceType
 	diags := req.Plan.Get(ctx, &orgQuotaType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -159,7 +163,9 @@ func (r *orgQuotaResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags = resp.State.Set(ctx, orgsQuotaType)
+	state := orgsQuotaType.Expand()
+	state.Timeouts = orgQuotaType.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -174,7 +180,7 @@ func (r *orgQuotaResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *orgQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var orgQuotaTypeState OrgQuotaType
+	var orgQuotaTypeState orgQuotaResourceType
 	diags := req.State.Get(ctx, &orgQuotaTypeState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -207,7 +213,9 @@ func (r *orgQuotaResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags = resp.State.Set(ctx, orgsQuotaType)
+	state := orgsQuotaType.Expand()
+	state.Timeouts = orgQuotaTypeState.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -227,8 +235,8 @@ func (r *orgQuotaResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *orgQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var orgQuotaTypePlan OrgQuotaType
-	var orgQuotaTypeState OrgQuotaType
+	var orgQuotaTypePlan orgQuotaResourceType
+	var orgQuotaTypeState orgQuotaResourceType
 	diags := req.Plan.Get(ctx, &orgQuotaTypePlan)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Get(ctx, &orgQuotaTypeState)
@@ -275,7 +283,9 @@ func (r *orgQuotaResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags = resp.State.Set(ctx, orgsQuotaType)
+	state := orgsQuotaType.Expand()
+	state.Timeouts = orgQuotaTypePlan.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -293,12 +303,19 @@ func (r *orgQuotaResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *orgQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var orgQuotaType OrgQuotaType
+	var orgQuotaType orgQuotaResourceType
 	diags := req.State.Get(ctx, &orgQuotaType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, diags := orgQuotaType.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := r.cfClient.OrganizationQuotas.Delete(ctx, orgQuotaType.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -307,7 +324,7 @@ func (r *orgQuotaResource) Delete(ctx context.Context, req resource.DeleteReques
 		)
 		return
 	}
-	if err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *r.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to verify org quota deletion",
 			"Org quota deletion verification failed for "+orgQuotaType.ID.ValueString()+": "+err.Error(),
@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure   = &OrgRoleResource{}
 	_ resource.ResourceWithImportState = &OrgRoleResource{}
 	_ resource.ResourceWithIdentity    = &OrgRoleResource{}
+	_ resource.ResourceWithMoveState   = &OrgRoleResource{}
 )
 
 // Instantiates a role resource.
@@ -110,6 +112,9 @@ func (r *OrgRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 			idKey:        guidSchema(),
 			createdAtKey: createdAtSchema(),
 			updatedAtKey: updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "organization role", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -173,6 +178,7 @@ func (r *OrgRoleResource) Create(ctx context.Context, req resource.CreateRequest
 	data := roleTypeResponse.ReduceToOrgRole()
 	data.UserName = plan.UserName
 	data.Origin = plan.Origin
+	data.Timeouts = plan.Timeouts
 
 	tflog.Trace(ctx, "created an org role resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -195,6 +201,21 @@ func (rs *OrgRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Roles moved from the community provider are looked up on their first refresh.
+	if state.Id.IsNull() {
+		opts := cfv3client.NewRoleListOptions()
+		opts.OrganizationGUIDs = cfv3client.Filter{Values: []string{state.Organization.ValueString()}}
+		role, err := findMovedRole(ctx, rs.cfClient, opts, state.Type.ValueString(), state.User, state.UserName, state.Origin)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Reading Moved Org Role",
+				"Could not find "+state.Type.ValueString()+" role moved from the community provider : "+err.Error(),
+			)
+			return
+		}
+		state.Id = types.StringValue(role.GUID)
+	}
+
 	role, err := rs.cfClient.Roles.Get(ctx, state.Id.ValueString())
 	if err != nil {
 		handleReadErrors(ctx, resp, err, "role", state.Id.ValueString())
@@ -205,6 +226,7 @@ func (rs *OrgRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data := roleTypeResponse.ReduceToOrgRole()
 	data.UserName = state.UserName
 	data.Origin = state.Origin
+	data.Timeouts = state.Timeouts
 
 	tflog.Trace(ctx, "read an org role resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -234,6 +256,12 @@ func (rs *OrgRoleResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Roles.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -243,7 +271,7 @@ func (rs *OrgRoleResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Role",
 			"Failed in deleting the role with ID "+state.Id.ValueString()+" and user ID "+state.User.ValueString()+" : "+err.Error(),
@@ -261,3 +289,9 @@ func (rs *OrgRoleResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("role_guid"), req, resp)
 }
+
+func (rs *OrgRoleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityOrgUsersStateMover(),
+	}
+}
@@ -89,4 +89,29 @@ func TestResourceOrg(t *testing.T) {
 
 	})
 
+	t.Run("happy path - create org with timeouts", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		resourceName := "cloudfoundry_org.timeouts_org"
+		rec := cfg.SetupVCR(t, "fixtures/resource_org_timeouts")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclOrg(&OrgModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "timeouts_org",
+						Name:          new("tf-org-timeouts-test"),
+						Timeouts:      new(`{ delete = "30m" }`),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "timeouts.delete", "30m"),
+					),
+				},
+			},
+		})
+	})
+
 }
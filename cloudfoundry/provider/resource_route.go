@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -27,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure   = &RouteResource{}
 	_ resource.ResourceWithImportState = &RouteResource{}
 	_ resource.ResourceWithIdentity    = &RouteResource{}
+	_ resource.ResourceWithMoveState   = &RouteResource{}
 )
 
 // Instantiates a security group resource.
@@ -167,6 +169,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "route", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -199,7 +204,7 @@ func (r *RouteResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan routeType
+	var plan routeResourceType
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -239,14 +244,16 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	plan, diags = mapRouteValuesToType(ctx, route)
+	data, diags := mapRouteValuesToType(ctx, route)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a route resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := routeResouerceIdentityModel{
-		RouteGUID: types.StringValue(plan.Id.ValueString()),
+		RouteGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -255,7 +262,7 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (rs *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data routeType
+	var data routeResourceType
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -268,11 +275,13 @@ func (rs *RouteResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	data, diags = mapRouteValuesToType(ctx, route)
+	routeData, diags := mapRouteValuesToType(ctx, route)
 	resp.Diagnostics.Append(diags...)
 
+	state := routeData.Expand()
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a route resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity routeResouerceIdentityModel
 
@@ -288,7 +297,7 @@ func (rs *RouteResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (rs *RouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState routeType
+	var plan, previousState routeResourceType
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
 	if resp.Diagnostics.HasError() {
@@ -329,8 +338,10 @@ func (rs *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data, diags := mapRouteValuesToType(ctx, route)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "updated a route resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
@@ -344,13 +355,19 @@ func (rs *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (rs *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state routeType
+	var state routeResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Routes.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -360,7 +377,7 @@ func (rs *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Route",
 			"Failed in deleting the Route with ID "+state.Id.ValueString()+" : "+err.Error(),
@@ -378,3 +395,9 @@ func (rs *RouteResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("route_guid"), req, resp)
 }
+
+func (rs *RouteResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_route", nil),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -134,6 +135,9 @@ func (r *SecurityGroupResource) Schema(ctx context.Context, req resource.SchemaR
 			},
 			createdAtKey: createdAtSchema(),
 			updatedAtKey: updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "security group", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -164,7 +168,7 @@ func (r *SecurityGroupResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan securityGroupType
+	var plan securityGroupResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -186,14 +190,16 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	plan, diags = mapSecurityGroupValuesToType(ctx, securityGroup)
+	data, diags := mapSecurityGroupValuesToType(ctx, securityGroup)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a security group resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := securityGroupResouerceIdentityModel{
-		SecurityGroupGUID: types.StringValue(plan.Id.ValueString()),
+		SecurityGroupGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -202,7 +208,7 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (rs *SecurityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data securityGroupType
+	var data securityGroupResourceType
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -215,11 +221,13 @@ func (rs *SecurityGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	data, diags = mapSecurityGroupValuesToType(ctx, securityGroup)
+	securityGroupData, diags := mapSecurityGroupValuesToType(ctx, securityGroup)
 	resp.Diagnostics.Append(diags...)
 
+	state := securityGroupData.Expand()
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a security group resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity securityGroupResouerceIdentityModel
 
@@ -236,7 +244,7 @@ func (rs *SecurityGroupResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (rs *SecurityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
-		plan, previousState                                                                securityGroupType
+		plan, previousState                                                                securityGroupResourceType
 		removedRunningSpaces, addedRunningSpaces, removedStagingSpaces, addedStagingSpaces []string
 		err                                                                                error
 	)
@@ -312,8 +320,10 @@ func (rs *SecurityGroupResource) Update(ctx context.Context, req resource.Update
 	data, diags := mapSecurityGroupValuesToType(ctx, securityGroup)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "updated a security group resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	identity := securityGroupResouerceIdentityModel{
@@ -326,13 +336,19 @@ func (rs *SecurityGroupResource) Update(ctx context.Context, req resource.Update
 }
 
 func (rs *SecurityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state securityGroupType
+	var state securityGroupResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.SecurityGroups.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -342,7 +358,7 @@ func (rs *SecurityGroupResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Security Group",
 			"Failed in deleting the Security Group with ID "+state.Id.ValueString()+" and name "+state.Name.ValueString()+" : "+err.Error(),
@@ -6,6 +6,7 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -72,6 +73,11 @@ func (r *serviceBrokerResource) Schema(ctx context.Context, req resource.SchemaR
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "service broker", timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -109,6 +115,12 @@ func (r *serviceBrokerResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createServiceBroker, diags := plan.mapCreateServiceBrokerTypeToValues(ctx)
 	resp.Diagnostics.Append(diags...)
 
@@ -121,7 +133,7 @@ func (r *serviceBrokerResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout)
+	err = pollJob(ctx, *r.cfClient, jobID, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to verify service broker creation",
@@ -148,6 +160,7 @@ func (r *serviceBrokerResource) Create(ctx context.Context, req resource.CreateR
 	resp.Diagnostics.Append(diags...)
 	data.Username = plan.Username
 	data.Password = plan.Password
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	identity := serviceBrokerResourceIdentityModel{
@@ -176,6 +189,7 @@ func (r *serviceBrokerResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(diags...)
 	state.Username = data.Username
 	state.Password = data.Password
+	state.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity serviceBrokerResourceIdentityModel
@@ -201,6 +215,12 @@ func (r *serviceBrokerResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateServiceBroker, diags := plan.mapUpdateServiceBrokerTypeToValues(ctx, previousState)
 	resp.Diagnostics.Append(diags...)
 
@@ -214,7 +234,7 @@ func (r *serviceBrokerResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if jobID != "" {
-		if err := pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+		if err := pollJob(ctx, *r.cfClient, jobID, updateTimeout); err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to verify service broker update",
 				"Service Broker update verification failed for "+plan.Name.ValueString()+": "+err.Error(),
@@ -235,6 +255,7 @@ func (r *serviceBrokerResource) Update(ctx context.Context, req resource.UpdateR
 	resp.Diagnostics.Append(diags...)
 	data.Username = plan.Username
 	data.Password = plan.Password
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	// WORKAROUND for OpenTofu compatibility
@@ -256,6 +277,12 @@ func (r *serviceBrokerResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := r.cfClient.ServiceBrokers.Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -264,7 +291,7 @@ func (r *serviceBrokerResource) Delete(ctx context.Context, req resource.DeleteR
 		)
 		return
 	}
-	if err := pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+	if err := pollJob(ctx, *r.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to verify service broker deletion",
 			"service broker deletion verification failed for "+state.ID.ValueString()+": "+err.Error(),
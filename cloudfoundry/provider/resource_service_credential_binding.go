@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type serviceCredentialBindingResource struct {
@@ -30,6 +35,8 @@ var (
 	_ resource.ResourceWithImportState    = &serviceCredentialBindingResource{}
 	_ resource.ResourceWithValidateConfig = &serviceCredentialBindingResource{}
 	_ resource.ResourceWithIdentity       = &serviceCredentialBindingResource{}
+	_ resource.ResourceWithModifyPlan     = &serviceCredentialBindingResource{}
+	_ resource.ResourceWithMoveState      = &serviceCredentialBindingResource{}
 )
 
 const (
@@ -37,6 +44,12 @@ const (
 	keyServiceCredentialBinding = "key"
 )
 
+const (
+	restartRotationRollout = "restart"
+	restageRotationRollout = "restage"
+	noneRotationRollout    = "none"
+)
+
 type serviceCredentialBindingResourceIdentityModel struct {
 	ServiceCredentialBindingGUID types.String `tfsdk:"service_credential_binding_guid"`
 }
@@ -47,6 +60,8 @@ func NewServiceCredentialBindingResource() resource.Resource {
 
 func (r *serviceCredentialBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service_credential_binding"
+	// The GUID of an app binding changes when it is rotated.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *serviceCredentialBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -54,7 +69,9 @@ func (r *serviceCredentialBindingResource) Schema(ctx context.Context, req resou
 		MarkdownDescription: `Service credential bindings are used to make the details of the connection to a service instance available to an app or a developer.
 		Service credential bindings can be of type app or key.
 		A service credential binding is of type app when it is a binding between a service instance and an application Not all services support this binding, as some services deliver value to users directly without integration with an application. Field broker_catalog.features.bindable from service plan of the service instance can be used to determine if it is bindable.
-		A service credential binding is of type key when it only retrieves the details of the service instance and makes them available to the developer.`,
+		A service credential binding is of type key when it only retrieves the details of the service instance and makes them available to the developer.
+
+__Rotation:__ Changing ` + "`rotation_trigger`" + ` replaces the binding without downtime: a new binding is created next to the old one and the old binding is deleted only after the new one is in use. An app binding requires the platform to allow multiple bindings between an app and a service instance, the app is rolled out with the new credentials before the old binding is deleted. A service key is named after ` + "`name`" + ` with a suffix derived from ` + "`rotation_trigger`" + ` as the names of service keys are unique, the old key is deleted once the new one has been created.`,
 
 		Attributes: map[string]schema.Attribute{
 			"type": schema.StringAttribute{
@@ -97,6 +114,17 @@ func (r *serviceCredentialBindingResource) Schema(ctx context.Context, req resou
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value which rotates the binding whenever it changes, e.g. a timestamp or a version.",
+				Optional:            true,
+			},
+			"rotation_rollout": schema.StringAttribute{
+				MarkdownDescription: "How the bound app picks up the new credentials on rotation. Either restart, restage or none. A started app is restarted or restaged with a rolling deployment, which has to finish within the update timeout, none leaves the rollout to the user. Defaults to restart. Only supported when the type is app.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(restartRotationRollout, restageRotationRollout, noneRotationRollout),
+				},
+			},
 			"credential_binding": schema.StringAttribute{
 				MarkdownDescription: "The service credential binding details.",
 				Computed:            true,
@@ -109,6 +137,11 @@ func (r *serviceCredentialBindingResource) Schema(ctx context.Context, req resou
 			annotationsKey:   resourceAnnotationsSchema(),
 			createdAtKey:     createdAtSchema(),
 			updatedAtKey:     updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "service credential binding", timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -139,7 +172,7 @@ func (r *serviceCredentialBindingResource) Configure(ctx context.Context, req re
 }
 
 func (r *serviceCredentialBindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config serviceCredentialBindingTypeWithCredentials
+	var config serviceCredentialBindingResourceType
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
@@ -174,83 +207,204 @@ func (r *serviceCredentialBindingResource) ValidateConfig(ctx context.Context, r
 		return
 
 	}
+
+	if config.Type.ValueString() == keyServiceCredentialBinding && !config.RotationRollout.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_rollout"),
+			"Invalid attribute combination",
+			"Rotation rollout should only be provided for credential bindings of type app",
+		)
+		return
+	}
+}
+
+func (r *serviceCredentialBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on creation and deletion.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state serviceCredentialBindingResourceType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name of a service key depends on its rotation trigger, so removing the trigger renames the key.
+	if plan.Type.ValueString() == keyServiceCredentialBinding && plan.RotationTrigger.IsNull() && !state.RotationTrigger.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotation_trigger"))
+		return
+	}
+	if !plan.isRotationRequested(state) {
+		return
+	}
+
+	// A rotation creates a new binding, so all values computed by the platform will change.
+	plan.ID = types.StringUnknown()
+	plan.Credentials = jsontypes.NewNormalizedUnknown()
+	plan.LastOperation = types.ObjectUnknown(lastOperationAttrTypes)
+	plan.CreatedAt = types.StringUnknown()
+	plan.UpdatedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *serviceCredentialBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var (
-		plan                     serviceCredentialBindingTypeWithCredentials
-		serviceCredentialBinding *cfv3resource.ServiceCredentialBinding
-		err                      error
-	)
+	var plan serviceCredentialBindingResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createServiceCredentialBinding, diags := plan.mapCreateServiceCredentialBindingTypeToValues(ctx)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	jobID, serviceCredentialBinding, err := r.cfClient.ServiceCredentialBindings.Create(ctx, &createServiceCredentialBinding)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"API Error in creating service Credential Binding",
-			"Unable to create Credential Binding "+plan.Name.ValueString()+": "+err.Error(),
-		)
+	serviceCredentialBinding, diags := r.createServiceCredentialBinding(ctx, plan, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
+	}
 
-	} else if jobID != "" {
-		err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to verify service credential binding creation",
-				"Service Credential Binding verification failed for "+plan.Name.ValueString()+": "+err.Error(),
-			)
-			return
-		}
+	data, diags := r.mapServiceCredentialBindingToState(ctx, serviceCredentialBinding, plan)
+	resp.Diagnostics.Append(diags...)
 
-		getOptions := cfv3client.ServiceCredentialBindingListOptions{
-			ServiceInstanceGUIDs: cfv3client.Filter{
-				Values: []string{
-					plan.ServiceInstance.ValueString(),
-				},
-			},
-		}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-		if plan.Type.ValueString() == "app" {
-			getOptions.AppGUIDs = cfv3client.Filter{
-				Values: []string{
-					plan.App.ValueString(),
-				},
-			}
-		} else {
-			getOptions.Names = cfv3client.Filter{
-				Values: []string{
-					plan.Name.ValueString(),
-				},
-			}
+	identity := serviceCredentialBindingResourceIdentityModel{
+		ServiceCredentialBindingGUID: types.StringValue(data.ID.ValueString()),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Creates the service credential binding and waits for the asynchronous creation to finish. Asynchronous creations
+// return no binding, it is looked up by its service instance and app or name instead.
+func (r *serviceCredentialBindingResource) createServiceCredentialBinding(ctx context.Context, plan serviceCredentialBindingResourceType, timeout time.Duration) (*cfv3resource.ServiceCredentialBinding, diag.Diagnostics) {
+	serviceCredentialBinding, _, diags := r.submitServiceCredentialBinding(ctx, plan, timeout)
+	if diags.HasError() || serviceCredentialBinding != nil {
+		return serviceCredentialBinding, diags
+	}
+
+	getOptions := cfv3client.NewServiceCredentialBindingListOptions()
+	getOptions.ServiceInstanceGUIDs = cfv3client.Filter{
+		Values: []string{
+			plan.ServiceInstance.ValueString(),
+		},
+	}
+
+	if plan.Type.ValueString() == appServiceCredentialBinding {
+		getOptions.AppGUIDs = cfv3client.Filter{
+			Values: []string{
+				plan.App.ValueString(),
+			},
 		}
-		serviceCredentialBinding, err = r.cfClient.ServiceCredentialBindings.Single(ctx, &getOptions)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error fetching service credential binding after creation",
-				"Unable to fetch created service credential binding "+plan.Name.ValueString()+": "+err.Error(),
-			)
-			return
+	} else {
+		getOptions.Names = cfv3client.Filter{
+			Values: []string{
+				plan.keyName(),
+			},
 		}
 	}
+	serviceCredentialBinding, err := r.cfClient.ServiceCredentialBindings.Single(ctx, getOptions)
+	if err != nil {
+		diags.AddError(
+			"Error fetching service credential binding after creation",
+			"Unable to fetch created service credential binding "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	return serviceCredentialBinding, diags
+}
 
-	data, diags := mapServiceCredentialBindingValuesToType(ctx, serviceCredentialBinding)
-	resp.Diagnostics.Append(diags...)
+// Creates an additional binding for a rotation. The service instance is bound twice until the rotation finished, so
+// the binding of an asynchronous creation is identified by the GUID its job links to.
+func (r *serviceCredentialBindingResource) createRotatedServiceCredentialBinding(ctx context.Context, plan serviceCredentialBindingResourceType, timeout time.Duration) (*cfv3resource.ServiceCredentialBinding, diag.Diagnostics) {
+	serviceCredentialBinding, jobID, diags := r.submitServiceCredentialBinding(ctx, plan, timeout)
+	if diags.HasError() || serviceCredentialBinding != nil {
+		return serviceCredentialBinding, diags
+	}
+
+	job, err := r.cfClient.Jobs.Get(ctx, jobID)
+	if err != nil {
+		diags.AddError(
+			"Error fetching service credential binding after creation",
+			"Unable to fetch job "+jobID+" of the created service credential binding "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	link, ok := job.Links["service_credential_binding"]
+	if !ok {
+		diags.AddError(
+			"Error fetching service credential binding after creation",
+			"Job "+jobID+" does not link to the created service credential binding "+plan.Name.ValueString(),
+		)
+		return nil, diags
+	}
+	guid := link.Href[strings.LastIndex(link.Href, "/")+1:]
+	serviceCredentialBinding, err = r.cfClient.ServiceCredentialBindings.Get(ctx, guid)
+	if err != nil {
+		diags.AddError(
+			"Error fetching service credential binding after creation",
+			"Unable to fetch created service credential binding "+guid+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	return serviceCredentialBinding, diags
+}
+
+// Creates the service credential binding and waits for an asynchronous creation to finish. The binding is only
+// returned for synchronous creations, otherwise the GUID of the finished job is returned.
+func (r *serviceCredentialBindingResource) submitServiceCredentialBinding(ctx context.Context, plan serviceCredentialBindingResourceType, timeout time.Duration) (*cfv3resource.ServiceCredentialBinding, string, diag.Diagnostics) {
+	createServiceCredentialBinding, diags := plan.mapCreateServiceCredentialBindingTypeToValues(ctx)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	jobID, serviceCredentialBinding, err := r.cfClient.ServiceCredentialBindings.Create(ctx, &createServiceCredentialBinding)
+	if err != nil {
+		diags.AddError(
+			"API Error in creating service Credential Binding",
+			"Unable to create Credential Binding "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return nil, "", diags
+	}
+	if jobID == "" {
+		return serviceCredentialBinding, "", diags
+	}
+
+	err = pollJob(ctx, *r.cfClient, jobID, timeout)
+	if err != nil {
+		diags.AddError(
+			"Unable to verify service credential binding creation",
+			"Service Credential Binding verification failed for "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return nil, "", diags
+	}
+	return nil, jobID, diags
+}
 
-	data.Parameters = plan.Parameters
+// Maps the binding to the resource state, keeping the configured values which cannot be read from the platform,
+// and adds the credentials of the binding.
+func (r *serviceCredentialBindingResource) mapServiceCredentialBindingToState(ctx context.Context, serviceCredentialBinding *cfv3resource.ServiceCredentialBinding, config serviceCredentialBindingResourceType) (serviceCredentialBindingResourceType, diag.Diagnostics) {
+	binding, diags := mapServiceCredentialBindingValuesToType(ctx, serviceCredentialBinding)
+	data := binding.Expand()
+
+	if data.Type.ValueString() == keyServiceCredentialBinding && data.Name.ValueString() == config.keyName() {
+		data.Name = config.Name
+	}
+	data.Parameters = config.Parameters
+	data.RotationTrigger = config.RotationTrigger
+	data.RotationRollout = config.RotationRollout
+	data.Timeouts = config.Timeouts
 
 	credentialDetails, err := r.cfClient.ServiceCredentialBindings.GetDetails(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddWarning(
+		diags.AddWarning(
 			"API Error Fetching Service Credential Binding Details.",
 			fmt.Sprintf("Request failed with %s.", err.Error()),
 		)
@@ -259,19 +413,11 @@ func (r *serviceCredentialBindingResource) Create(ctx context.Context, req resou
 		credentialJSON, _ := json.Marshal(credentialDetails)
 		data.Credentials = jsontypes.NewNormalizedValue(string(credentialJSON))
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
-	identity := serviceCredentialBindingResourceIdentityModel{
-		ServiceCredentialBindingGUID: types.StringValue(data.ID.ValueString()),
-	}
-
-	diags = resp.Identity.Set(ctx, identity)
-	resp.Diagnostics.Append(diags...)
+	return data, diags
 }
 
 func (r *serviceCredentialBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data serviceCredentialBindingTypeWithCredentials
+	var data serviceCredentialBindingResourceType
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -284,23 +430,9 @@ func (r *serviceCredentialBindingResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	state, diags := mapServiceCredentialBindingValuesToType(ctx, serviceCredentialBinding)
+	state, diags := r.mapServiceCredentialBindingToState(ctx, serviceCredentialBinding, data)
 	resp.Diagnostics.Append(diags...)
 
-	state.Parameters = data.Parameters
-
-	credentialDetails, err := r.cfClient.ServiceCredentialBindings.GetDetails(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddWarning(
-			"API Error Fetching Service Credential Binding Details.",
-			fmt.Sprintf("Request failed with %s.", err.Error()),
-		)
-		state.Credentials = jsontypes.NewNormalizedNull()
-	} else {
-		credentialJSON, _ := json.Marshal(credentialDetails)
-		state.Credentials = jsontypes.NewNormalizedValue(string(credentialJSON))
-	}
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity serviceCredentialBindingResourceIdentityModel
@@ -318,10 +450,23 @@ func (r *serviceCredentialBindingResource) Read(ctx context.Context, req resourc
 }
 
 func (r *serviceCredentialBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState serviceCredentialBindingTypeWithCredentials
+	var plan, previousState serviceCredentialBindingResourceType
 	var diags diag.Diagnostics
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.isRotationRequested(previousState) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.rotateServiceCredentialBinding(ctx, plan, previousState, updateTimeout, resp)
+		return
+	}
 
 	updateServiceCredentialBinding, diags := plan.mapUpdateServiceCredentialBindingTypeToValues(ctx, previousState)
 	resp.Diagnostics.Append(diags...)
@@ -338,61 +483,134 @@ func (r *serviceCredentialBindingResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	data, diags := mapServiceCredentialBindingValuesToType(ctx, serviceCredentialBinding)
+	data, diags := r.mapServiceCredentialBindingToState(ctx, serviceCredentialBinding, plan)
 	resp.Diagnostics.Append(diags...)
 
-	data.Parameters = plan.Parameters
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-	credentialDetails, err := r.cfClient.ServiceCredentialBindings.GetDetails(ctx, data.ID.ValueString())
-	if err != nil {
+	// WORKAROUND for OpenTofu compatibility
+	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
+	identity := serviceCredentialBindingResourceIdentityModel{
+		ServiceCredentialBindingGUID: types.StringValue(previousState.ID.ValueString()),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	// END WORKAROUND
+}
+
+// Replaces the binding without downtime. The new binding is created next to the old one and a bound app is rolled
+// out to pick up its credentials. The old binding is only deleted after the rollout succeeded, otherwise the new
+// binding is deleted again and the app keeps using the old one.
+func (r *serviceCredentialBindingResource) rotateServiceCredentialBinding(ctx context.Context, plan serviceCredentialBindingResourceType, previousState serviceCredentialBindingResourceType, timeout time.Duration, resp *resource.UpdateResponse) {
+	serviceCredentialBinding, diags := r.createRotatedServiceCredentialBinding(ctx, plan, timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "created service credential binding for rotation", map[string]any{"old": previousState.ID.ValueString(), "new": serviceCredentialBinding.GUID})
+
+	if err := r.rolloutBoundApp(ctx, plan, timeout); err != nil {
+		detail := "Could not roll out app " + plan.App.ValueString() + " with the new service credential binding " + serviceCredentialBinding.GUID + " : " + err.Error()
+		if deleteErr := r.deleteServiceCredentialBinding(ctx, serviceCredentialBinding.GUID, timeout); deleteErr != nil {
+			detail += "\nThe new service credential binding could not be deleted again and has to be removed manually : " + deleteErr.Error()
+		} else {
+			detail += "\nThe new service credential binding has been deleted again, the app still uses " + previousState.ID.ValueString() + "."
+		}
+		resp.Diagnostics.AddError(
+			"API Error Rotating Service Credential Binding",
+			detail,
+		)
+		return
+	}
+
+	if err := r.deleteServiceCredentialBinding(ctx, previousState.ID.ValueString(), timeout); err != nil {
 		resp.Diagnostics.AddWarning(
-			"API Error Fetching Service Credential Binding Details.",
-			fmt.Sprintf("Request failed with %s.", err.Error()),
+			"API Error Deleting Rotated Service Credential Binding",
+			"The app has been rolled out with the new service credential binding, but the old binding "+previousState.ID.ValueString()+" could not be deleted and has to be removed manually : "+err.Error(),
 		)
-		data.Credentials = jsontypes.NewNormalizedNull()
-	} else {
-		credentialJSON, _ := json.Marshal(credentialDetails)
-		data.Credentials = jsontypes.NewNormalizedValue(string(credentialJSON))
 	}
 
+	data, diags := r.mapServiceCredentialBindingToState(ctx, serviceCredentialBinding, plan)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-	// WORKAROUND for OpenTofu compatibility
-	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
 	identity := serviceCredentialBindingResourceIdentityModel{
-		ServiceCredentialBindingGUID: types.StringValue(previousState.ID.ValueString()),
+		ServiceCredentialBindingGUID: types.StringValue(data.ID.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
-	// END WORKAROUND
+}
+
+// Rolls out the bound app with a rolling deployment so that it picks up the credentials of the latest binding.
+// Stopped apps pick them up on their next start. Staging and the deployment have to finish within the timeout.
+// Service keys are not bound to an app, so there is nothing to roll out.
+func (r *serviceCredentialBindingResource) rolloutBoundApp(ctx context.Context, plan serviceCredentialBindingResourceType, timeout time.Duration) error {
+	if plan.Type.ValueString() == keyServiceCredentialBinding || plan.RotationRollout.ValueString() == noneRotationRollout {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	appResource := &appResource{cfClient: r.cfClient}
+	app, err := r.cfClient.Applications.Get(ctx, plan.App.ValueString())
+	if err != nil {
+		return fmt.Errorf("failed to fetch app %s: %w", plan.App.ValueString(), err)
+	}
+	if app.State != "STARTED" {
+		return nil
+	}
+
+	appType := AppType{
+		Strategy:                  types.StringValue("rolling"),
+		AppDeployedRunningTimeout: types.Int64Value(int64(math.Ceil(timeout.Minutes()))),
+	}
+	dropletGUID := ""
+	if plan.RotationRollout.ValueString() == restageRotationRollout {
+		dropletGUID, err = appResource.restageDroplet(ctx, app)
+		if err != nil {
+			return err
+		}
+	}
+	return appResource.restartApp(ctx, app, dropletGUID, appType)
 }
 
 func (r *serviceCredentialBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state serviceCredentialBindingTypeWithCredentials
+	var state serviceCredentialBindingResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	jobID, err := r.cfClient.ServiceCredentialBindings.Delete(ctx, state.ID.ValueString())
-	if err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.deleteServiceCredentialBinding(ctx, state.ID.ValueString(), deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error in deleting service credential binding",
 			"Unable to delete credential binding "+state.Name.ValueString()+": "+err.Error(),
 		)
+	}
+}
 
+// Deletes the service credential binding and waits for the asynchronous deletion to finish.
+func (r *serviceCredentialBindingResource) deleteServiceCredentialBinding(ctx context.Context, guid string, timeout time.Duration) error {
+	jobID, err := r.cfClient.ServiceCredentialBindings.Delete(ctx, guid)
+	if err != nil {
+		return err
 	}
 	if jobID != "" {
-		if err := pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to verify service credential binding deletion",
-				"service credential binding deletion verification failed for "+state.ID.ValueString()+": "+err.Error(),
-			)
+		if err := pollJob(ctx, *r.cfClient, jobID, timeout); err != nil {
+			return fmt.Errorf("service credential binding deletion verification failed for %s: %w", guid, err)
 		}
 	}
-
+	return nil
 }
 
 func (rs *serviceCredentialBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -402,3 +620,9 @@ func (rs *serviceCredentialBindingResource) ImportState(ctx context.Context, req
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("service_credential_binding_guid"), req, resp)
 }
+
+func (rs *serviceCredentialBindingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_service_key", mapCommunityServiceKeyState),
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 type ResourceServiceCredentialBindingModelPtr struct {
@@ -21,10 +24,13 @@ type ResourceServiceCredentialBindingModelPtr struct {
 	Type            *string
 	App             *string
 	Parameters      *string
+	RotationTrigger *string
+	RotationRollout *string
 	ServiceInstance *string
 	LastOperation   *string
 	CreatedAt       *string
 	UpdatedAt       *string
+	Timeouts        *string
 }
 
 func hclResourceServiceCredentialBinding(sip *ResourceServiceCredentialBindingModelPtr) string {
@@ -57,6 +63,12 @@ func hclResourceServiceCredentialBinding(sip *ResourceServiceCredentialBindingMo
 				{{.Parameters}}
 				EOT
 			{{- end -}}
+			{{if .RotationTrigger}}
+				rotation_trigger = "{{.RotationTrigger}}"
+			{{- end -}}
+			{{if .RotationRollout}}
+				rotation_rollout = "{{.RotationRollout}}"
+			{{- end -}}
 			{{if .LastOperation}}
 				last_operation = "{{.LastOperation}}"
 			{{- end -}}
@@ -65,6 +77,9 @@ func hclResourceServiceCredentialBinding(sip *ResourceServiceCredentialBindingMo
 			{{- end -}}
 			{{if .UpdatedAt}}
 				updated_at = "{{.UpdatedAt}}"
+			{{- end -}}
+			{{if .Timeouts}}
+				timeouts = {{.Timeouts}}
 			{{- end }}
 		}`
 		tmpl, err := template.New("service_credential_binding").Parse(s)
@@ -92,6 +107,7 @@ func TestResourceServiceCredentialBinding(t *testing.T) {
 		testAppGUID                         = "e04e63c1-6e69-4537-b9e2-95ab6f3ebfcf"
 		testApp2GUID                        = "ede9258f-5b3d-4837-906f-8b4b2c4cbe58"
 		testApp3GUID                        = "f3d8f5c5-1bcb-489e-87a7-3011d042e4d0"
+		testAppWithoutPackageGUID           = "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"
 	)
 	t.Parallel()
 	t.Run("happy path - create service key for managed service instance", func(t *testing.T) {
@@ -155,6 +171,61 @@ func TestResourceServiceCredentialBinding(t *testing.T) {
 			},
 		})
 	})
+	t.Run("happy path - create and update service key with timeouts", func(t *testing.T) {
+		resourceName := "cloudfoundry_service_credential_binding.si_timeouts"
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_timeouts")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_timeouts",
+						Name:            new("test-sk-timeouts"),
+						Type:            new(keyServiceCredentialBinding),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Labels:          new(testCreateLabel),
+						Timeouts:        new(`{ create = "30m", update = "45m" }`),
+					}),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("timeouts").AtMapKey("create"), knownvalue.StringExact("30m")),
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("timeouts").AtMapKey("update"), knownvalue.StringExact("45m")),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestCheckResourceAttr(resourceName, "timeouts.create", "30m"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_timeouts",
+						Name:            new("test-sk-timeouts"),
+						Type:            new(keyServiceCredentialBinding),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Labels:          new(testUpdateLabel),
+						Timeouts:        new(`{ create = "30m", update = "1h" }`),
+					}),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+							plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("timeouts").AtMapKey("update"), knownvalue.StringExact("1h")),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "labels.purpose", "production"),
+						resource.TestCheckResourceAttr(resourceName, "timeouts.update", "1h"),
+					),
+				},
+			},
+		})
+	})
 	t.Run("happy path - create app binding for user-provided service instance", func(t *testing.T) {
 		resourceName := "cloudfoundry_service_credential_binding.si_user_provided"
 		cfg := getCFHomeConf()
@@ -286,6 +357,209 @@ func TestResourceServiceCredentialBinding(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - rotate app binding", func(t *testing.T) {
+		resourceName := "cloudfoundry_service_credential_binding.si_rotated"
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_rotation")
+		defer stopQuietly(rec)
+		bindingGUIDs := statecheck.CompareValue(compare.ValuesDiffer())
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_rotated",
+						Type:            new(appServiceCredentialBinding),
+						ServiceInstance: new(testUserProvidedServiceInstanceGUID),
+						App:             new(testActionAppGUID),
+						RotationTrigger: new("v1"),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v1"),
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						bindingGUIDs.AddStateValue(resourceName, tfjsonpath.New("id")),
+					},
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_rotated",
+						Type:            new(appServiceCredentialBinding),
+						ServiceInstance: new(testUserProvidedServiceInstanceGUID),
+						App:             new(testActionAppGUID),
+						RotationTrigger: new("v2"),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v2"),
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+						resource.TestMatchResourceAttr(resourceName, "credential_binding", regexp.MustCompile(`password`)),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						bindingGUIDs.AddStateValue(resourceName, tfjsonpath.New("id")),
+						statecheck.ExpectIdentityValueMatchesStateAtPath(resourceName, tfjsonpath.New("service_credential_binding_guid"), tfjsonpath.New("id")),
+					},
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_rotated",
+						Type:            new(appServiceCredentialBinding),
+						ServiceInstance: new(testUserProvidedServiceInstanceGUID),
+						App:             new(testActionAppGUID),
+						RotationTrigger: new("v3"),
+						RotationRollout: new(restageRotationRollout),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v3"),
+						resource.TestCheckResourceAttr(resourceName, "rotation_rollout", restageRotationRollout),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						bindingGUIDs.AddStateValue(resourceName, tfjsonpath.New("id")),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - rotate app binding with failing rollout", func(t *testing.T) {
+		resourceName := "cloudfoundry_service_credential_binding.si_rotated"
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_rotation_failed_rollout")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_rotated",
+						Type:            new(appServiceCredentialBinding),
+						ServiceInstance: new(testUserProvidedServiceInstanceGUID),
+						App:             new(testAppWithoutPackageGUID),
+						RotationTrigger: new("v1"),
+						RotationRollout: new(restageRotationRollout),
+					}),
+					Check: resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v1"),
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "si_rotated",
+						Type:            new(appServiceCredentialBinding),
+						ServiceInstance: new(testUserProvidedServiceInstanceGUID),
+						App:             new(testAppWithoutPackageGUID),
+						RotationTrigger: new("v2"),
+						RotationRollout: new(restageRotationRollout),
+					}),
+					ExpectError: regexp.MustCompile(`API Error Rotating Service Credential Binding`),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - rotate service key", func(t *testing.T) {
+		resourceName := "cloudfoundry_service_credential_binding.service_key"
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_key_rotation")
+		defer stopQuietly(rec)
+		keyGUIDs := statecheck.CompareValue(compare.ValuesDiffer())
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "service_key",
+						Name:            new(testServiceKeyManagedCreate),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+						RotationTrigger: new("v1"),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "name", testServiceKeyManagedCreate),
+						resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v1"),
+						resource.TestMatchResourceAttr(resourceName, "id", regexpValidUUID),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						keyGUIDs.AddStateValue(resourceName, tfjsonpath.New("id")),
+					},
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "service_key",
+						Name:            new(testServiceKeyManagedCreate),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+						RotationTrigger: new("v2"),
+					}),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "name", testServiceKeyManagedCreate),
+						resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "v2"),
+						resource.TestMatchResourceAttr(resourceName, "credential_binding", regexp.MustCompile(`password`)),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						keyGUIDs.AddStateValue(resourceName, tfjsonpath.New("id")),
+						statecheck.ExpectIdentityValueMatchesStateAtPath(resourceName, tfjsonpath.New("service_credential_binding_guid"), tfjsonpath.New("id")),
+					},
+				},
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "service_key",
+						Name:            new(testServiceKeyManagedCreate),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+					}),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "name", testServiceKeyManagedCreate),
+						resource.TestCheckNoResourceAttr(resourceName, "rotation_trigger"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - rotation rollout for service key", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_rotation_invalid")
+		defer stopQuietly(rec)
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclResourceServiceCredentialBinding(&ResourceServiceCredentialBindingModelPtr{
+						HclType:         hclObjectResource,
+						HclObjectName:   "service_key",
+						Name:            new(testServiceKeyManagedCreate),
+						ServiceInstance: new(testManagedServiceInstanceGUID),
+						Type:            new(keyServiceCredentialBinding),
+						RotationTrigger: new("v1"),
+						RotationRollout: new(restartRotationRollout),
+					}),
+					ExpectError: regexp.MustCompile(`Invalid attribute combination`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - create app binding with existing name", func(t *testing.T) {
 		cfg := getCFHomeConf()
 		rec := cfg.SetupVCR(t, "fixtures/resource_service_credential_binding_invalid_name")
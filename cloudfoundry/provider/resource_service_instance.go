@@ -28,6 +28,7 @@ import (
 
 type serviceInstanceResource struct {
 	cfClient *cfv3client.Client
+	lookups  *managers.LookupCache
 }
 
 var (
@@ -36,6 +37,7 @@ var (
 	_ resource.ResourceWithImportState    = &serviceInstanceResource{}
 	_ resource.ResourceWithValidateConfig = &serviceInstanceResource{}
 	_ resource.ResourceWithIdentity       = &serviceInstanceResource{}
+	_ resource.ResourceWithMoveState      = &serviceInstanceResource{}
 )
 
 const (
@@ -212,6 +214,7 @@ func (r *serviceInstanceResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 	r.cfClient = session.CFClient
+	r.lookups = session.Lookups
 }
 
 func (r *serviceInstanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -749,17 +752,20 @@ func (rs *serviceInstanceResource) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("service_instance_guid"), req, resp)
 }
 
+func (rs *serviceInstanceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_service_instance", mapCommunityServiceInstanceState),
+		communityStateMover("cloudfoundry_user_provided_service", mapCommunityUserProvidedServiceState),
+	}
+}
+
 // resolvePlanGUID looks up a service plan GUID from its offering name and plan name.
 func (r *serviceInstanceResource) resolvePlanGUID(ctx context.Context, servicePlanName, serviceOfferingName string) (string, error) {
-	opts := &cfv3client.ServicePlanListOptions{
-		Names:                cfv3client.Filter{Values: []string{servicePlanName}},
-		ServiceOfferingNames: cfv3client.Filter{Values: []string{serviceOfferingName}},
-	}
-	plan, err := r.cfClient.ServicePlans.Single(ctx, opts)
+	planGUID, _, err := servicePlanLookup(r.cfClient, "", serviceOfferingName, servicePlanName).resolve(ctx, r.lookups)
 	if err != nil {
 		return "", fmt.Errorf("could not find unique service plan %q for offering %q: %w", servicePlanName, serviceOfferingName, err)
 	}
-	return plan.GUID, nil
+	return planGUID, nil
 }
 
 // lookupPlanNames resolves the service plan name and offering name from a plan GUID.
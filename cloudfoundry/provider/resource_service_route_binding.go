@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -79,6 +80,10 @@ Not all service instances support route binding. In order to bind to a managed s
 			annotationsKey:   resourceAnnotationsSchema(),
 			createdAtKey:     createdAtSchema(),
 			updatedAtKey:     updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "service route binding", timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -120,6 +125,12 @@ func (r *serviceRouteBindingResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createServiceRouteBinding, diags := plan.mapCreateServiceRouteBindingTypeToValues(ctx)
 	resp.Diagnostics.Append(diags...)
 
@@ -132,7 +143,7 @@ func (r *serviceRouteBindingResource) Create(ctx context.Context, req resource.C
 		return
 
 	} else if jobID != "" {
-		err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout)
+		err = pollJob(ctx, *r.cfClient, jobID, createTimeout)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to verify service Route binding creation",
@@ -166,6 +177,7 @@ func (r *serviceRouteBindingResource) Create(ctx context.Context, req resource.C
 
 	data, diags := mapServiceRouteBindingValuesToType(ctx, serviceRouteBinding)
 	data.Parameters = plan.Parameters
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -193,6 +205,7 @@ func (r *serviceRouteBindingResource) Read(ctx context.Context, req resource.Rea
 
 	state, diags := mapServiceRouteBindingValuesToType(ctx, serviceRouteBinding)
 	state.Parameters = data.Parameters
+	state.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -233,6 +246,7 @@ func (r *serviceRouteBindingResource) Update(ctx context.Context, req resource.U
 
 	data, diags := mapServiceRouteBindingValuesToType(ctx, serviceRouteBinding)
 	data.Parameters = plan.Parameters
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -255,6 +269,12 @@ func (r *serviceRouteBindingResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := r.cfClient.ServiceRouteBindings.Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -264,7 +284,7 @@ func (r *serviceRouteBindingResource) Delete(ctx context.Context, req resource.D
 
 	}
 	if jobID != "" {
-		if err := pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+		if err := pollJob(ctx, *r.cfClient, jobID, deleteTimeout); err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to verify service route binding deletion",
 				"service route binding deletion verification failed for "+state.ID.ValueString()+": "+err.Error(),
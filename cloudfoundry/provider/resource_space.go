@@ -7,6 +7,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
@@ -23,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure   = &SpaceResource{}
 	_ resource.ResourceWithImportState = &SpaceResource{}
 	_ resource.ResourceWithIdentity    = &SpaceResource{}
+	_ resource.ResourceWithMoveState   = &SpaceResource{}
 )
 
 // Instantiates a space resource.
@@ -75,7 +77,7 @@ func (r *SpaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 			},
 			"isolation_segment": schema.StringAttribute{
-				MarkdownDescription: "The ID of the isolation segment to assign to the space. The isolation segment must be entitled to the space's parent organization",
+				MarkdownDescription: "The ID of the isolation segment to assign to the space. The isolation segment must be entitled to the space's parent organization. When the isolation segment is managed with the `cloudfoundry_space_isolation_segment` resource, leave it unset and add it to `ignore_changes` instead.",
 				Optional:            true,
 				Validators: []validator.String{
 					validation.ValidUUID(),
@@ -86,6 +88,9 @@ func (r *SpaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "space", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -118,7 +123,7 @@ func (r *SpaceResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 func (r *SpaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
-	var plan spaceType
+	var plan spaceResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -171,14 +176,16 @@ func (r *SpaceResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	plan, diags = mapSpaceValuesToType(ctx, space, allowSSH, plan.IsolationSegment.ValueString())
+	data, diags := mapSpaceValuesToType(ctx, space, allowSSH, plan.IsolationSegment.ValueString())
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a space resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := SpaceResouerceIdentityModel{
-		SpaceGUID: types.StringValue(plan.Id.ValueString()),
+		SpaceGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -186,7 +193,7 @@ func (r *SpaceResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (rs *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data spaceType
+	var data spaceResourceType
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -218,12 +225,14 @@ func (rs *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	data, diags = mapSpaceValuesToType(ctx, space, sshEnabled, isolationSegment)
+	spaceData, diags := mapSpaceValuesToType(ctx, space, sshEnabled, isolationSegment)
 	resp.Diagnostics.Append(diags...)
 
+	state := spaceData.Expand()
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a space resource")
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity SpaceResouerceIdentityModel
 
@@ -239,7 +248,7 @@ func (rs *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (rs *SpaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState spaceType
+	var plan, previousState spaceResourceType
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
 	if resp.Diagnostics.HasError() {
@@ -283,8 +292,10 @@ func (rs *SpaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data, diags := mapSpaceValuesToType(ctx, space, plan.AllowSSH.ValueBool(), plan.IsolationSegment.ValueString())
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "updated a space resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
@@ -299,7 +310,7 @@ func (rs *SpaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 func (rs *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 
-	var state spaceType
+	var state spaceResourceType
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -307,6 +318,12 @@ func (rs *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Spaces.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -316,7 +333,7 @@ func (rs *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Space",
 			"Failed in deleting the space with ID "+state.Id.ValueString()+" and name "+state.Name.ValueString()+": "+err.Error(),
@@ -335,3 +352,9 @@ func (rs *SpaceResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("space_guid"), req, resp)
 }
+
+func (rs *SpaceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communityStateMover("cloudfoundry_space", nil),
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &spaceFeaturesResource{}
+	_ resource.ResourceWithConfigure   = &spaceFeaturesResource{}
+	_ resource.ResourceWithImportState = &spaceFeaturesResource{}
+)
+
+// Instantiates a space features resource.
+func NewSpaceFeaturesResource() resource.Resource {
+	return &spaceFeaturesResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type spaceFeaturesResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *spaceFeaturesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_features"
+}
+
+func (r *spaceFeaturesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the features of a space. Only the configured features are changed, the others keep the value set on the platform. Destroying the resource leaves the features of the space unchanged.
+
+__Note:__ Do not set ` + "`allow_ssh`" + ` of the ` + "`cloudfoundry_space`" + ` resource when managing the ssh feature with this resource.`,
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the space.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh": schema.BoolAttribute{
+				MarkdownDescription: "Whether SSH access to the app instances in the space is allowed.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the space.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *spaceFeaturesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *spaceFeaturesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spaceFeaturesType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.updateSpaceFeatures(ctx, plan, spaceFeaturesType{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Space Features",
+			"Could not update features of space "+plan.Space.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a space features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceFeaturesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data spaceFeaturesType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshEnabled, err := r.cfClient.SpaceFeatures.IsSSHEnabled(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "space features", data.Id.ValueString())
+		return
+	}
+
+	data = mapSpaceFeaturesValuesToType(data.Id.ValueString(), sshEnabled)
+
+	tflog.Trace(ctx, "read a space features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceFeaturesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, previousState spaceFeaturesType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.updateSpaceFeatures(ctx, plan, previousState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Updating Space Features",
+			"Could not update features of space "+plan.Space.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "updated a space features resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceFeaturesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The features of a space cannot be removed, the resource is only removed from the state.
+	tflog.Trace(ctx, "deleted a space features resource")
+}
+
+func (r *spaceFeaturesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Updates the features whose planned value differs from the previous state and reads back the features of the space.
+func (r *spaceFeaturesResource) updateSpaceFeatures(ctx context.Context, plan spaceFeaturesType, previousState spaceFeaturesType) (spaceFeaturesType, error) {
+	if !plan.SSH.IsNull() && !plan.SSH.IsUnknown() && !plan.SSH.Equal(previousState.SSH) {
+		if err := r.cfClient.SpaceFeatures.EnableSSH(ctx, plan.Space.ValueString(), plan.SSH.ValueBool()); err != nil {
+			return spaceFeaturesType{}, fmt.Errorf("could not set feature %s : %w", spaceFeatureSSH, err)
+		}
+	}
+
+	sshEnabled, err := r.cfClient.SpaceFeatures.IsSSHEnabled(ctx, plan.Space.ValueString())
+	if err != nil {
+		return spaceFeaturesType{}, err
+	}
+	return mapSpaceFeaturesValuesToType(plan.Space.ValueString(), sshEnabled), nil
+}
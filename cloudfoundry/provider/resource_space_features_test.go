@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type SpaceFeaturesModelPtr struct {
+	HclType       string
+	HclObjectName string
+	Space         *string
+	SSH           *bool
+}
+
+func hclSpaceFeatures(sfmp *SpaceFeaturesModelPtr) string {
+	if sfmp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_space_features" {{.HclObjectName}} {
+			{{- if .Space}}
+				space = "{{.Space}}"
+			{{- end -}}
+			{{if .SSH}}
+				ssh = {{.SSH}}
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_space_features").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, sfmp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return sfmp.HclType + ` "cloudfoundry_space_features" ` + sfmp.HclObjectName + ` {}`
+}
+
+func TestSpaceFeaturesResource_Configure(t *testing.T) {
+	var (
+		resourceName     = "cloudfoundry_space_features.rs"
+		testSpaceGUID    = "02c0cc92-6ecc-44b1-b7b2-096ca19ee143"
+		invalidSpaceGUID = "00000000-0000-0000-0000-000000000000"
+	)
+	t.Parallel()
+	t.Run("happy path - create/update/import space features", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_space_features_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclSpaceFeatures(&SpaceFeaturesModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &testSpaceGUID,
+						SSH:           new(false),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", testSpaceGUID),
+						resource.TestCheckResourceAttr(resourceName, "ssh", "false"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclSpaceFeatures(&SpaceFeaturesModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &testSpaceGUID,
+						SSH:           new(true),
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "ssh", "true"),
+					),
+				},
+				{
+					ResourceName:      resourceName,
+					ImportStateId:     testSpaceGUID,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+	t.Run("error path - update features of non-existent space", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_space_features_invalid_space")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclSpaceFeatures(&SpaceFeaturesModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &invalidSpaceGUID,
+						SSH:           new(true),
+					}),
+					ExpectError: regexp.MustCompile(`API Error Updating Space Features`),
+				},
+			},
+		})
+	})
+}
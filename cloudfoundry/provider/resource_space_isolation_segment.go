@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &spaceIsolationSegmentResource{}
+	_ resource.ResourceWithConfigure   = &spaceIsolationSegmentResource{}
+	_ resource.ResourceWithImportState = &spaceIsolationSegmentResource{}
+)
+
+// Instantiates a space isolation segment resource.
+func NewSpaceIsolationSegmentResource() resource.Resource {
+	return &spaceIsolationSegmentResource{}
+}
+
+// Contains reference to the v3 client to be used for making the API calls.
+type spaceIsolationSegmentResource struct {
+	cfClient *cfv3client.Client
+}
+
+func (r *spaceIsolationSegmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_isolation_segment"
+}
+
+func (r *spaceIsolationSegmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Assigns an isolation segment to a space. The isolation segment must be entitled to the organization of the space. Destroying the resource removes the isolation segment from the space, its apps then run in the default isolation segment of the organization.
+
+Running apps only move to the new isolation segment after they are restarted, the started apps of the space are therefore listed in ` + "`affected_apps`" + `.
+
+__Note:__ The ` + "`cloudfoundry_space`" + ` resource reports an isolation segment assigned by this resource as a change of its ` + "`isolation_segment`" + ` attribute. Do not set ` + "`isolation_segment`" + ` on a space managed with this resource and add it to ` + "`ignore_changes`" + ` in the ` + "`lifecycle`" + ` block of the space.`,
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the space.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"segment": schema.StringAttribute{
+				MarkdownDescription: "The GUID of the isolation segment to assign to the space.",
+				Required:            true,
+				Validators: []validator.String{
+					validation.ValidUUID(),
+				},
+			},
+			"affected_apps": schema.SetAttribute{
+				MarkdownDescription: "The GUIDs of the apps which were started when the isolation segment was last assigned and must be restarted to run in it. Empty after an import.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			idKey: schema.StringAttribute{
+				MarkdownDescription: "The GUID of the space.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *spaceIsolationSegmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	session, ok := req.ProviderData.(*managers.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *managers.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.cfClient = session.CFClient
+}
+
+func (r *spaceIsolationSegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spaceIsolationSegmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.assignIsolationSegment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a space isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceIsolationSegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data spaceIsolationSegmentType
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, err := r.cfClient.Spaces.GetAssignedIsolationSegment(ctx, data.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, resp, err, "space isolation segment", data.Id.ValueString())
+		return
+	}
+	if segment == "" {
+		resp.Diagnostics.AddWarning(
+			"Isolation Segment Removed",
+			"The space "+data.Id.ValueString()+" has no isolation segment anymore, the resource is removed from the state",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = mapSpaceIsolationSegmentValuesToType(data.Id.ValueString(), segment, data.AffectedApps)
+
+	tflog.Trace(ctx, "read a space isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceIsolationSegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan spaceIsolationSegmentType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.assignIsolationSegment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a space isolation segment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceIsolationSegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spaceIsolationSegmentType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfClient.Spaces.AssignIsolationSegment(ctx, state.Space.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Error Removing Isolation Segment",
+			"Could not remove the isolation segment from space with ID "+state.Space.ValueString()+" : "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a space isolation segment resource")
+}
+
+func (r *spaceIsolationSegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Assigns the isolation segment to the space and collects its started apps.
+func (r *spaceIsolationSegmentResource) assignIsolationSegment(ctx context.Context, plan spaceIsolationSegmentType) (spaceIsolationSegmentType, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	err := r.cfClient.Spaces.AssignIsolationSegment(ctx, plan.Space.ValueString(), plan.Segment.ValueString())
+	if err != nil {
+		diagnostics.AddError(
+			"API Error Assigning Isolation Segment",
+			"Could not assign the Isolation Segment with ID "+plan.Segment.ValueString()+" on space with ID "+plan.Space.ValueString()+" : "+err.Error(),
+		)
+		return spaceIsolationSegmentType{}, diagnostics
+	}
+
+	affectedApps, err := listStartedApps(ctx, *r.cfClient, []string{plan.Space.ValueString()})
+	if err != nil {
+		diagnostics.AddError(
+			"API Error Fetching Apps",
+			"Could not list the apps of space with ID "+plan.Space.ValueString()+" : "+err.Error(),
+		)
+		return spaceIsolationSegmentType{}, diagnostics
+	}
+	if len(affectedApps) > 0 {
+		diagnostics.AddWarning(
+			"Apps Must Be Restarted",
+			"The following apps must be restarted to run in the isolation segment with ID "+plan.Segment.ValueString()+" : "+strings.Join(affectedApps, ", "),
+		)
+	}
+
+	affectedAppsSet, diags := types.SetValueFrom(ctx, types.StringType, affectedApps)
+	diagnostics.Append(diags...)
+
+	return mapSpaceIsolationSegmentValuesToType(plan.Space.ValueString(), plan.Segment.ValueString(), affectedAppsSet), diagnostics
+}
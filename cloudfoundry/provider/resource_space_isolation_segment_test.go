@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type SpaceIsolationSegmentModelPtr struct {
+	HclType       string
+	HclObjectName string
+	Space         *string
+	Segment       *string
+}
+
+func hclSpaceIsolationSegment(sismp *SpaceIsolationSegmentModelPtr) string {
+	if sismp != nil {
+		s := `
+		{{.HclType}} "cloudfoundry_space_isolation_segment" {{.HclObjectName}} {
+			{{- if .Space}}
+				space = "{{.Space}}"
+			{{- end -}}
+			{{if .Segment}}
+				segment = "{{.Segment}}"
+			{{- end }}
+			}`
+		tmpl, err := template.New("resource_space_isolation_segment").Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, sismp)
+		if err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+	return sismp.HclType + ` "cloudfoundry_space_isolation_segment" ` + sismp.HclObjectName + ` {}`
+}
+
+func TestSpaceIsolationSegmentResource_Configure(t *testing.T) {
+	var (
+		resourceName           = "cloudfoundry_space_isolation_segment.rs"
+		testSpaceGUID          = "02c0cc92-6ecc-44b1-b7b2-096ca19ee143"
+		segmentGUID            = "63ae51b9-9073-4409-81b0-3704b8de85dd"
+		updatedSegmentGUID     = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		notEntitledSegmentGUID = "40b73419-5e01-4be0-baea-932d46cea45b"
+	)
+	t.Parallel()
+	t.Run("happy path - create/update/import space isolation segment", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_space_isolation_segment_crud")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclSpaceIsolationSegment(&SpaceIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &testSpaceGUID,
+						Segment:       &segmentGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", testSpaceGUID),
+						resource.TestCheckResourceAttr(resourceName, "segment", segmentGUID),
+						resource.TestCheckResourceAttr(resourceName, "affected_apps.#", "2"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "affected_apps.*", "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"),
+					),
+				},
+				{
+					Config: hclProvider(nil) + hclSpaceIsolationSegment(&SpaceIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &testSpaceGUID,
+						Segment:       &updatedSegmentGUID,
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "segment", updatedSegmentGUID),
+						resource.TestCheckResourceAttr(resourceName, "affected_apps.#", "2"),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportStateId:           testSpaceGUID,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"affected_apps"},
+				},
+			},
+		})
+	})
+	t.Run("error path - assign isolation segment not entitled to the org of the space", func(t *testing.T) {
+		cfg := getCFHomeConf()
+		rec := cfg.SetupVCR(t, "fixtures/resource_space_isolation_segment_not_entitled")
+		defer stopQuietly(rec)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(rec.GetDefaultClient()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProvider(nil) + hclSpaceIsolationSegment(&SpaceIsolationSegmentModelPtr{
+						HclType:       hclObjectResource,
+						HclObjectName: "rs",
+						Space:         &testSpaceGUID,
+						Segment:       &notEntitledSegmentGUID,
+					}),
+					ExpectError: regexp.MustCompile(`API Error Assigning Isolation Segment`),
+				},
+			},
+		})
+	})
+}
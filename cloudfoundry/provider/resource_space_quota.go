@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,7 +43,7 @@ func (r *spaceQuotaResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_space_quota"
 }
 
-func (r *spaceQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *spaceQuotaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Provides a Cloud Foundry resource to manage space quota definitions. Only spaces assigned via Terraform are managed. On deletion, Terraform removes these assignments before deleting the quota. Non-Terraform managed assignments are not removed, which may cause deletion to fail.",
 		Attributes: map[string]schema.Attribute{
@@ -112,6 +113,9 @@ func (r *spaceQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			idKey:        guidSchema(),
 			createdAtKey: createdAtSchema(),
 			updatedAtKey: updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "space quota", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -142,7 +146,7 @@ func (r *spaceQuotaResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *spaceQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var spaceQuotaType spaceQuotaType
+	var spaceQuotaType spaceQuotaResourceType
 	diags := req.Plan.Get(ctx, &spaceQuotaType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -167,7 +171,9 @@ func (r *spaceQuotaResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags = resp.State.Set(ctx, spacesQuotaType)
+	state := spacesQuotaType.Expand()
+	state.Timeouts = spaceQuotaType.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -182,7 +188,7 @@ func (r *spaceQuotaResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *spaceQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var spaceQuotaTypeState spaceQuotaType
+	var spaceQuotaTypeState spaceQuotaResourceType
 	diags := req.State.Get(ctx, &spaceQuotaTypeState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -218,7 +224,9 @@ func (r *spaceQuotaResource) Read(ctx context.Context, req resource.ReadRequest,
 	diags = spacesQuotaType.mapSpaceQuotaAssignmentValuestoType(ctx, spaceQuotaTypeState.Spaces)
 	resp.Diagnostics.Append(diags...)
 
-	diags = resp.State.Set(ctx, spacesQuotaType)
+	state := spacesQuotaType.Expand()
+	state.Timeouts = spaceQuotaTypeState.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -238,8 +246,8 @@ func (r *spaceQuotaResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *spaceQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var spaceQuotaTypePlan spaceQuotaType
-	var spaceQuotaTypeState spaceQuotaType
+	var spaceQuotaTypePlan spaceQuotaResourceType
+	var spaceQuotaTypeState spaceQuotaResourceType
 	diags := req.Plan.Get(ctx, &spaceQuotaTypePlan)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Get(ctx, &spaceQuotaTypeState)
@@ -295,7 +303,9 @@ func (r *spaceQuotaResource) Update(ctx context.Context, req resource.UpdateRequ
 	diags = spacesQuotaType.mapSpaceQuotaAssignmentValuestoType(ctx, spaceQuotaTypePlan.Spaces)
 	resp.Diagnostics.Append(diags...)
 
-	diags = resp.State.Set(ctx, spacesQuotaType)
+	state := spacesQuotaType.Expand()
+	state.Timeouts = spaceQuotaTypePlan.Timeouts
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -313,13 +323,19 @@ func (r *spaceQuotaResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *spaceQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var spaceQuotaType spaceQuotaType
+	var spaceQuotaType spaceQuotaResourceType
 	diags := req.State.Get(ctx, &spaceQuotaType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := spaceQuotaType.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Iterate through all Terraform-managed spaces that are currently assigned to this space quota
 	var assignedSpaces []string
 	diags = spaceQuotaType.Spaces.ElementsAs(ctx, &assignedSpaces, false)
@@ -343,7 +359,7 @@ func (r *spaceQuotaResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 		return
 	}
-	if err = pollJob(ctx, *r.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *r.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to verify space quota deletion",
 			"space quota deletion verification failed for "+spaceQuotaType.ID.ValueString()+": "+err.Error(),
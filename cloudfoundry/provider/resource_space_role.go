@@ -8,6 +8,7 @@ import (
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure   = &SpaceRoleResource{}
 	_ resource.ResourceWithImportState = &SpaceRoleResource{}
 	_ resource.ResourceWithIdentity    = &SpaceRoleResource{}
+	_ resource.ResourceWithMoveState   = &SpaceRoleResource{}
 )
 
 // Instantiates a role resource.
@@ -109,6 +111,9 @@ func (r *SpaceRoleResource) Schema(ctx context.Context, req resource.SchemaReque
 			idKey:        guidSchema(),
 			createdAtKey: createdAtSchema(),
 			updatedAtKey: updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "space role", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -170,6 +175,7 @@ func (r *SpaceRoleResource) Create(ctx context.Context, req resource.CreateReque
 	data := roleTypeResponse.ReduceToSpaceRole()
 	data.UserName = plan.UserName
 	data.Origin = plan.Origin
+	data.Timeouts = plan.Timeouts
 
 	tflog.Trace(ctx, "created a space role resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -192,6 +198,21 @@ func (rs *SpaceRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Roles moved from the community provider are looked up on their first refresh.
+	if state.Id.IsNull() {
+		opts := cfv3client.NewRoleListOptions()
+		opts.SpaceGUIDs = cfv3client.Filter{Values: []string{state.Space.ValueString()}}
+		role, err := findMovedRole(ctx, rs.cfClient, opts, state.Type.ValueString(), state.User, state.UserName, state.Origin)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API Error Reading Moved Space Role",
+				"Could not find "+state.Type.ValueString()+" role moved from the community provider : "+err.Error(),
+			)
+			return
+		}
+		state.Id = types.StringValue(role.GUID)
+	}
+
 	role, err := rs.cfClient.Roles.Get(ctx, state.Id.ValueString())
 	if err != nil {
 		handleReadErrors(ctx, resp, err, "role", state.Id.ValueString())
@@ -202,6 +223,7 @@ func (rs *SpaceRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 	data := roleTypeResponse.ReduceToSpaceRole()
 	data.UserName = state.UserName
 	data.Origin = state.Origin
+	data.Timeouts = state.Timeouts
 
 	tflog.Trace(ctx, "read a space role resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -231,6 +253,12 @@ func (rs *SpaceRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Roles.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -240,7 +268,7 @@ func (rs *SpaceRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting Role",
 			"Failed in deleting the role with ID "+state.Id.ValueString()+" and user ID "+state.User.ValueString()+" : "+err.Error(),
@@ -258,3 +286,9 @@ func (rs *SpaceRoleResource) ImportState(ctx context.Context, req resource.Impor
 	}
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("role_guid"), req, resp)
 }
+
+func (rs *SpaceRoleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		communitySpaceUsersStateMover(),
+	}
+}
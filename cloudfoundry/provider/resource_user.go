@@ -9,6 +9,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
@@ -95,6 +96,9 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
 			idKey:          guidSchema(),
+			"timeouts": timeoutsSchema(ctx, "user", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -182,14 +186,15 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		}
 	}
 
-	plan, diags = mapUserResourcesValuesToType(ctx, uaaUser, cfUser, plan.Password)
+	state, diags := mapUserResourcesValuesToType(ctx, uaaUser, cfUser, plan.Password)
 	resp.Diagnostics.Append(diags...)
 
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a user resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := userResourceIdentityModel{
-		UserGUID: types.StringValue(plan.Id.ValueString()),
+		UserGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -221,11 +226,12 @@ func (rs *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	data, diags = mapUserResourcesValuesToType(ctx, uaaUser, cfUser, data.Password)
+	state, diags := mapUserResourcesValuesToType(ctx, uaaUser, cfUser, data.Password)
 	resp.Diagnostics.Append(diags...)
 
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a user resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity userResourceIdentityModel
 
@@ -297,6 +303,8 @@ func (rs *UserResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data, diags := mapUserResourcesValuesToType(ctx, uaaUser, cfUser, plan.Password)
 	resp.Diagnostics.Append(diags...)
 
+	data.Timeouts = plan.Timeouts
+
 	tflog.Trace(ctx, "updated a user resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -320,6 +328,12 @@ func (rs *UserResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Users.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -329,7 +343,7 @@ func (rs *UserResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting CF User",
 			"Failed in deleting the user with ID "+state.Id.ValueString()+" : "+err.Error(),
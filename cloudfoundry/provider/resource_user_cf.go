@@ -6,6 +6,7 @@ import (
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -97,6 +98,9 @@ func (r *UserCFResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			annotationsKey: resourceAnnotationsSchema(),
 			createdAtKey:   createdAtSchema(),
 			updatedAtKey:   updatedAtSchema(),
+			"timeouts": timeoutsSchema(ctx, "user", timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -121,7 +125,7 @@ func (r *UserCFResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *UserCFResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var (
-		plan userType
+		plan userCFResourceType
 		err  error
 		user *cfv3resource.User
 	)
@@ -167,14 +171,16 @@ func (r *UserCFResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	plan, diags = mapUserValuesToType(ctx, user)
+	data, diags := mapUserValuesToType(ctx, user)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "created a cf user resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	identity := userCfResourceIdentityModel{
-		UserGUID: types.StringValue(plan.Id.ValueString()),
+		UserGUID: types.StringValue(state.Id.ValueString()),
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
@@ -182,7 +188,7 @@ func (r *UserCFResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (rs *UserCFResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data userType
+	var data userCFResourceType
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -196,11 +202,13 @@ func (rs *UserCFResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	data, diags = mapUserValuesToType(ctx, cfUser)
+	userData, diags := mapUserValuesToType(ctx, cfUser)
 	resp.Diagnostics.Append(diags...)
 
+	state := userData.Expand()
+	state.Timeouts = data.Timeouts
 	tflog.Trace(ctx, "read a cf user resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	var identity userCfResourceIdentityModel
 
@@ -217,7 +225,7 @@ func (rs *UserCFResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (rs *UserCFResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, previousState userType
+	var plan, previousState userCFResourceType
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
 	if resp.Diagnostics.HasError() {
@@ -239,8 +247,10 @@ func (rs *UserCFResource) Update(ctx context.Context, req resource.UpdateRequest
 	data, diags := mapUserValuesToType(ctx, cfUser)
 	resp.Diagnostics.Append(diags...)
 
+	state := data.Expand()
+	state.Timeouts = plan.Timeouts
 	tflog.Trace(ctx, "updated a cf user resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// WORKAROUND for OpenTofu compatibility
 	// https://github.com/cloudfoundry/terraform-provider-cloudfoundry/issues/418
@@ -255,13 +265,19 @@ func (rs *UserCFResource) Update(ctx context.Context, req resource.UpdateRequest
 
 func (rs *UserCFResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 
-	var state userType
+	var state userCFResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	jobID, err := rs.cfClient.Users.Delete(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -271,7 +287,7 @@ func (rs *UserCFResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err = pollJob(ctx, *rs.cfClient, jobID, defaultTimeout); err != nil {
+	if err = pollJob(ctx, *rs.cfClient, jobID, deleteTimeout); err != nil {
 		resp.Diagnostics.AddError(
 			"API Error Deleting CF User",
 			"Failed in deleting the user with ID "+state.Id.ValueString()+" : "+err.Error(),
@@ -0,0 +1,12 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Terraform struct for storing values for the access token ephemeral resource.
+type accessTokenType struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	APIURL      types.String `tfsdk:"api_url"`
+	UAAURL      types.String `tfsdk:"uaa_url"`
+}
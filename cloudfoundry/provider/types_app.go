@@ -13,6 +13,7 @@ import (
 	cfv3operation "github.com/cloudfoundry/go-cfclient/v3/operation"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,7 +33,12 @@ type AppType struct {
 	SourceCodeHash                        types.String       `tfsdk:"source_code_hash"`
 	DockerImage                           types.String       `tfsdk:"docker_image"`
 	DockerCredentials                     *DockerCredentials `tfsdk:"docker_credentials"`
+	Lifecycle                             types.String       `tfsdk:"lifecycle_type"`
+	CNBCredentials                        types.Map          `tfsdk:"cnb_credentials"`
 	Strategy                              types.String       `tfsdk:"strategy"`
+	CanarySteps                           []CanaryStep       `tfsdk:"canary_steps"`
+	MaxInFlight                           types.Int64        `tfsdk:"max_in_flight"`
+	Revision                              types.String       `tfsdk:"revision"`
 	ServiceBindings                       types.Set          `tfsdk:"service_bindings"`
 	Routes                                types.Set          `tfsdk:"routes"`
 	Stopped                               types.Bool         `tfsdk:"stopped"`
@@ -62,6 +68,12 @@ type AppType struct {
 	Timeout                               types.Int64        `tfsdk:"timeout"`
 	Labels                                types.Map          `tfsdk:"labels"`
 	Annotations                           types.Map          `tfsdk:"annotations"`
+	DeploymentID                          types.String       `tfsdk:"deployment_id"`
+	DeploymentStatus                      types.String       `tfsdk:"deployment_status"`
+	DeploymentReason                      types.String       `tfsdk:"deployment_reason"`
+	CurrentRevisionID                     types.String       `tfsdk:"current_revision_id"`
+	CurrentRevisionVersion                types.Int64        `tfsdk:"current_revision_version"`
+	Timeouts                              timeouts.Value     `tfsdk:"timeouts"`
 }
 
 type DatasourceAppType struct {
@@ -73,6 +85,7 @@ type DatasourceAppType struct {
 	Buildpacks                            types.List                   `tfsdk:"buildpacks"`
 	DockerImage                           types.String                 `tfsdk:"docker_image"`
 	DockerCredentials                     *DataSourceDockerCredentials `tfsdk:"docker_credentials"`
+	Lifecycle                             types.String                 `tfsdk:"lifecycle_type"`
 	ServiceBindings                       types.Set                    `tfsdk:"service_bindings"`
 	Routes                                types.Set                    `tfsdk:"routes"`
 	Environment                           types.Map                    `tfsdk:"environment"`
@@ -112,6 +125,11 @@ func (a *DatasourceAppType) Expand() AppType {
 	return expanded
 }
 
+type CanaryStep struct {
+	InstanceWeight types.Int64  `tfsdk:"instance_weight"`
+	Pause          types.String `tfsdk:"pause"`
+}
+
 type Sidecar struct {
 	Name         types.String `tfsdk:"name"`
 	Command      types.String `tfsdk:"command"`
@@ -141,6 +159,25 @@ type DockerCredentials struct {
 	Password types.String `tfsdk:"password"`
 }
 
+type CNBCredentials struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Token    types.String `tfsdk:"token"`
+}
+
+// cnbLifecycle extends the cnb lifecycle data of go-cfclient with the registry credentials.
+type cnbLifecycle struct {
+	Buildpacks  []string                         `json:"buildpacks,omitempty"`
+	Stack       string                           `json:"stack,omitempty"`
+	Credentials map[string]cnbRegistryCredential `json:"credentials,omitempty"`
+}
+
+type cnbRegistryCredential struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
 type DataSourceDockerCredentials struct {
 	Username types.String `tfsdk:"username"`
 }
@@ -183,6 +220,9 @@ func (appType *AppType) mapAppTypeToValues(ctx context.Context) (*cfv3operation.
 		diags = append(diags, tempDiags...)
 		appmanifest.Buildpacks = buildpacks
 	}
+	if !appType.Lifecycle.IsUnknown() && !appType.Lifecycle.IsNull() {
+		appmanifest.Lifecycle = cfv3operation.AppLifecycle(appType.Lifecycle.ValueString())
+	}
 	if !appType.DockerImage.IsNull() {
 		appManifestDocker := cfv3operation.AppManifestDocker{
 			Image: appType.DockerImage.ValueString(),
@@ -660,6 +700,7 @@ func mapAppValuesToType(ctx context.Context, appManifest *cfv3operation.AppManif
 	appType.ID = types.StringValue(app.GUID)
 	appType.CreatedAt = types.StringValue(app.CreatedAt.Format(time.RFC3339))
 	appType.UpdatedAt = types.StringValue(app.UpdatedAt.Format(time.RFC3339))
+	appType.Lifecycle = types.StringValue(app.Lifecycle.Type)
 
 	appType.Labels, tempDiags = mapMetadataValueToType(ctx, app.Metadata.Labels)
 	diags = append(diags, tempDiags...)
@@ -669,16 +710,59 @@ func mapAppValuesToType(ctx context.Context, appManifest *cfv3operation.AppManif
 	return appType, diags
 }
 
+func (appType *AppType) mapCNBCredentialsToValues(ctx context.Context) (map[string]cnbRegistryCredential, diag.Diagnostics) {
+	var tfCredentials map[string]CNBCredentials
+	diags := appType.CNBCredentials.ElementsAs(ctx, &tfCredentials, false)
+	credentials := make(map[string]cnbRegistryCredential, len(tfCredentials))
+	for registry, c := range tfCredentials {
+		credentials[registry] = cnbRegistryCredential{
+			Username: c.Username.ValueString(),
+			Password: c.Password.ValueString(),
+			Token:    c.Token.ValueString(),
+		}
+	}
+	return credentials, diags
+}
+
+// Reports whether the provider creates the deployments of the app itself and thus tracks the latest deployment and
+// the deployed revision. Pushes with other strategies are rolled out by go-cfclient.
+func (appType *AppType) tracksDeployments() bool {
+	strategy := appType.Strategy.ValueString()
+	return strategy == "canary" || strategy == "rolling" && !appType.MaxInFlight.IsNull() || !appType.Revision.IsNull()
+}
+
+func (appType *AppType) mapDeploymentValuesToType(deployment *cfv3resource.Deployment, revision *cfv3resource.Revision) {
+	appType.DeploymentID = types.StringNull()
+	appType.DeploymentStatus = types.StringNull()
+	appType.DeploymentReason = types.StringNull()
+	appType.CurrentRevisionID = types.StringNull()
+	appType.CurrentRevisionVersion = types.Int64Null()
+	if deployment != nil {
+		appType.DeploymentID = types.StringValue(deployment.GUID)
+		appType.DeploymentStatus = types.StringValue(deployment.Status.Value)
+		appType.DeploymentReason = types.StringValue(deployment.Status.Reason)
+	}
+	if revision != nil {
+		appType.CurrentRevisionID = types.StringValue(revision.GUID)
+		appType.CurrentRevisionVersion = types.Int64Value(int64(revision.Version))
+	}
+}
+
 func (target *AppType) CopyConfigAttributes(source *AppType) {
 	target.Space = source.Space
 	target.Org = source.Org
 	target.Path = source.Path
 	target.Strategy = source.Strategy
+	target.CanarySteps = source.CanarySteps
+	target.MaxInFlight = source.MaxInFlight
+	target.Revision = source.Revision
+	target.CNBCredentials = source.CNBCredentials
 	target.SourceCodeHash = source.SourceCodeHash
 	target.RandomRoute = source.RandomRoute
 	target.NoRoute = source.NoRoute
 	target.AppDeployedRunningTimeout = source.AppDeployedRunningTimeout
 	target.AppDeployedRunningCheckInterval = source.AppDeployedRunningCheckInterval
+	target.Timeouts = source.Timeouts
 }
 
 func getDesiredType(actual string, desired string) (string, error) {
@@ -703,6 +787,19 @@ func getDesiredType(actual string, desired string) (string, error) {
 	}
 }
 
+// sizeUnitExponents maps the byte size units accepted by Cloud Foundry to their power of 1024.
+var sizeUnitExponents = map[string]float64{
+	"B":  0,
+	"K":  1,
+	"KB": 1,
+	"M":  2,
+	"MB": 2,
+	"G":  3,
+	"GB": 3,
+	"T":  4,
+	"TB": 4,
+}
+
 func convertToDesiredType(actual string, desired string) (float64, string, error) {
 	// Find actual unit from string
 	actualValue, actualUnit, err := splitValueAndUnit(actual)
@@ -713,18 +810,7 @@ func convertToDesiredType(actual string, desired string) (float64, string, error
 	if err != nil {
 		return 0, "", err
 	}
-	m := map[string]float64{
-		"B":  0,
-		"K":  1,
-		"KB": 1,
-		"M":  2,
-		"MB": 2,
-		"G":  3,
-		"GB": 3,
-		"T":  4,
-		"TB": 4,
-	}
-	dist := m[actualUnit] - m[desiredUnit]
+	dist := sizeUnitExponents[actualUnit] - sizeUnitExponents[desiredUnit]
 	res := actualValue * math.Pow(1024, dist)
 	return res, desiredUnit, nil
 }
@@ -914,6 +1000,7 @@ func mapAppDatasourceValuesToType(ctx context.Context, appManifest *cfv3operatio
 	appType.ID = types.StringValue(app.GUID)
 	appType.CreatedAt = types.StringValue(app.CreatedAt.Format(time.RFC3339))
 	appType.UpdatedAt = types.StringValue(app.UpdatedAt.Format(time.RFC3339))
+	appType.Lifecycle = types.StringValue(app.Lifecycle.Type)
 
 	appType.Labels, tempDiags = mapMetadataValueToType(ctx, app.Metadata.Labels)
 	diags = append(diags, tempDiags...)
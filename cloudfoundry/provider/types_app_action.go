@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type appRestartActionType struct {
+	App                             types.String `tfsdk:"app"`
+	Strategy                        types.String `tfsdk:"strategy"`
+	MaxInFlight                     types.Int64  `tfsdk:"max_in_flight"`
+	AppDeployedRunningTimeout       types.Int64  `tfsdk:"app_deployed_running_timeout"`
+	AppDeployedRunningCheckInterval types.Int64  `tfsdk:"app_deployed_running_check_interval"`
+}
+
+type appScaleActionType struct {
+	App                             types.String `tfsdk:"app"`
+	ProcessType                     types.String `tfsdk:"process_type"`
+	Instances                       types.Int64  `tfsdk:"instances"`
+	Memory                          types.String `tfsdk:"memory"`
+	DiskQuota                       types.String `tfsdk:"disk_quota"`
+	LogRateLimitPerSecond           types.String `tfsdk:"log_rate_limit_per_second"`
+	Strategy                        types.String `tfsdk:"strategy"`
+	MaxInFlight                     types.Int64  `tfsdk:"max_in_flight"`
+	AppDeployedRunningTimeout       types.Int64  `tfsdk:"app_deployed_running_timeout"`
+	AppDeployedRunningCheckInterval types.Int64  `tfsdk:"app_deployed_running_check_interval"`
+}
+
+// Maps the rollout options of the action to an app type as expected by the deployment helpers of the app resource.
+func (a *appRestartActionType) mapRolloutOptionsToAppType() AppType {
+	return AppType{
+		Strategy:                        a.Strategy,
+		MaxInFlight:                     a.MaxInFlight,
+		AppDeployedRunningTimeout:       a.AppDeployedRunningTimeout,
+		AppDeployedRunningCheckInterval: a.AppDeployedRunningCheckInterval,
+	}
+}
+
+func (a *appScaleActionType) mapRolloutOptionsToAppType() AppType {
+	return AppType{
+		Strategy:                        a.Strategy,
+		MaxInFlight:                     a.MaxInFlight,
+		AppDeployedRunningTimeout:       a.AppDeployedRunningTimeout,
+		AppDeployedRunningCheckInterval: a.AppDeployedRunningCheckInterval,
+	}
+}
+
+// Converts the configured scaling values with units to the process scale request.
+func (a *appScaleActionType) mapScaleValuesToProcessScale() (*cfv3resource.ProcessScale, error) {
+	scale := &cfv3resource.ProcessScale{}
+	if !a.Instances.IsNull() {
+		scale.Instances = new(int(a.Instances.ValueInt64()))
+	}
+	if !a.Memory.IsNull() {
+		memory, err := convertToMegabytes(a.Memory.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory %s: %w", a.Memory.ValueString(), err)
+		}
+		scale.MemoryInMB = &memory
+	}
+	if !a.DiskQuota.IsNull() {
+		disk, err := convertToMegabytes(a.DiskQuota.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid disk quota %s: %w", a.DiskQuota.ValueString(), err)
+		}
+		scale.DiskInMB = &disk
+	}
+	if !a.LogRateLimitPerSecond.IsNull() {
+		logRate, err := convertToBytes(a.LogRateLimitPerSecond.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid log rate limit %s: %w", a.LogRateLimitPerSecond.ValueString(), err)
+		}
+		scale.LogRateLimitInBytesPerSecond = &logRate
+	}
+	return scale, nil
+}
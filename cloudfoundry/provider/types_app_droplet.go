@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dropletStateStaged = "STAGED"
+	dropletStateFailed = "FAILED"
+)
+
+// Terraform struct for storing values for app droplet resource.
+type AppDropletType struct {
+	Id             types.String   `tfsdk:"id"`
+	App            types.String   `tfsdk:"app"`
+	SourceApp      types.String   `tfsdk:"source_app"`
+	SourceDroplet  types.String   `tfsdk:"source_droplet"`
+	Path           types.String   `tfsdk:"path"`
+	SourceCodeHash types.String   `tfsdk:"source_code_hash"`
+	ProcessTypes   types.Map      `tfsdk:"process_types"`
+	State          types.String   `tfsdk:"state"`
+	Lifecycle      types.String   `tfsdk:"lifecycle_type"`
+	Stack          types.String   `tfsdk:"stack"`
+	Buildpacks     types.List     `tfsdk:"buildpacks"`
+	Image          types.String   `tfsdk:"image"`
+	Checksum       types.String   `tfsdk:"checksum"`
+	Labels         types.Map      `tfsdk:"labels"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	UpdatedAt      types.String   `tfsdk:"updated_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Sets the droplet resource values for creation with cf-client from the terraform struct values.
+func (data *AppDropletType) mapCreateAppDropletTypeToValues(ctx context.Context) (*cfv3resource.DropletCreate, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	createDroplet := cfv3resource.NewDropletCreate(data.App.ValueString())
+	createDroplet.ProcessTypes = map[string]string{}
+	if !data.ProcessTypes.IsNull() && !data.ProcessTypes.IsUnknown() {
+		diagnostics.Append(data.ProcessTypes.ElementsAs(ctx, &createDroplet.ProcessTypes, false)...)
+	}
+
+	return createDroplet, diagnostics
+}
+
+// Sets the terraform struct values from the droplet resource returned by the cf-client.
+// The source of the droplet is carried over from the plan as it cannot be read back from the droplet.
+func mapAppDropletValuesToType(ctx context.Context, droplet *cfv3resource.Droplet, plan AppDropletType) (AppDropletType, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	appDropletType := AppDropletType{
+		Id:             types.StringValue(droplet.GUID),
+		App:            plan.App,
+		SourceApp:      plan.SourceApp,
+		SourceDroplet:  plan.SourceDroplet,
+		Path:           plan.Path,
+		SourceCodeHash: plan.SourceCodeHash,
+		Timeouts:       plan.Timeouts,
+		State:          types.StringValue(string(droplet.State)),
+		Lifecycle:      types.StringValue(droplet.Lifecycle.Type),
+		CreatedAt:      types.StringValue(droplet.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:      types.StringValue(droplet.UpdatedAt.Format(time.RFC3339)),
+	}
+	if droplet.Relationships.App.Data != nil {
+		appDropletType.App = types.StringValue(droplet.Relationships.App.Data.GUID)
+	}
+	if appDropletType.SourceDroplet.IsUnknown() {
+		appDropletType.SourceDroplet = types.StringNull()
+	}
+
+	appDropletType.ProcessTypes, diags = types.MapValueFrom(ctx, types.StringType, droplet.ProcessTypes)
+	diagnostics.Append(diags...)
+
+	buildpacks := make([]string, 0, len(droplet.Buildpacks))
+	for _, buildpack := range droplet.Buildpacks {
+		buildpacks = append(buildpacks, buildpack.Name)
+	}
+	appDropletType.Buildpacks, diags = types.ListValueFrom(ctx, types.StringType, buildpacks)
+	diagnostics.Append(diags...)
+
+	if droplet.Stack != "" {
+		appDropletType.Stack = types.StringValue(droplet.Stack)
+	} else {
+		appDropletType.Stack = types.StringNull()
+	}
+	if droplet.Image != nil {
+		appDropletType.Image = types.StringValue(*droplet.Image)
+	} else {
+		appDropletType.Image = types.StringNull()
+	}
+	if droplet.Checksum.Value != "" {
+		appDropletType.Checksum = types.StringValue(droplet.Checksum.Value)
+	} else {
+		appDropletType.Checksum = types.StringNull()
+	}
+
+	if droplet.Metadata != nil {
+		appDropletType.Labels, diags = mapMetadataValueToType(ctx, droplet.Metadata.Labels)
+		diagnostics.Append(diags...)
+		appDropletType.Annotations, diags = mapMetadataValueToType(ctx, droplet.Metadata.Annotations)
+		diagnostics.Append(diags...)
+	} else {
+		appDropletType.Labels = types.MapNull(types.StringType)
+		appDropletType.Annotations = types.MapNull(types.StringType)
+	}
+
+	return appDropletType, diagnostics
+}
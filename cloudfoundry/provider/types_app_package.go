@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Terraform struct for storing values for app package data source.
+type AppPackageType struct {
+	Id           types.String `tfsdk:"id"`
+	App          types.String `tfsdk:"app"`
+	Type         types.String `tfsdk:"type"`
+	State        types.String `tfsdk:"state"`
+	Image        types.String `tfsdk:"image"`
+	ChecksumType types.String `tfsdk:"checksum_type"`
+	Checksum     types.String `tfsdk:"checksum"`
+	Error        types.String `tfsdk:"error"`
+	Labels       types.Map    `tfsdk:"labels"`
+	Annotations  types.Map    `tfsdk:"annotations"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// Sets the terraform struct values from the package resource returned by the cf-client.
+func mapAppPackageValuesToType(ctx context.Context, pkg *cfv3resource.Package) (AppPackageType, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	appPackageType := AppPackageType{
+		Id:           types.StringValue(pkg.GUID),
+		App:          types.StringNull(),
+		Type:         types.StringValue(pkg.Type),
+		State:        types.StringValue(string(pkg.State)),
+		Image:        types.StringNull(),
+		ChecksumType: types.StringNull(),
+		Checksum:     types.StringNull(),
+		Error:        types.StringNull(),
+		CreatedAt:    types.StringValue(pkg.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:    types.StringValue(pkg.UpdatedAt.Format(time.RFC3339)),
+	}
+	if pkg.Relationships.App.Data != nil {
+		appPackageType.App = types.StringValue(pkg.Relationships.App.Data.GUID)
+	}
+
+	if pkg.Data.Bits != nil {
+		appPackageType.ChecksumType = types.StringValue(pkg.Data.Bits.Checksum.Type)
+		if pkg.Data.Bits.Checksum.Value != nil {
+			appPackageType.Checksum = types.StringValue(*pkg.Data.Bits.Checksum.Value)
+		}
+		if pkg.Data.Bits.Error != nil {
+			appPackageType.Error = types.StringValue(*pkg.Data.Bits.Error)
+		}
+	}
+	if pkg.Data.Docker != nil {
+		appPackageType.Image = types.StringValue(pkg.Data.Docker.Image)
+	}
+
+	if pkg.Metadata != nil {
+		appPackageType.Labels, diags = mapMetadataValueToType(ctx, pkg.Metadata.Labels)
+		diagnostics.Append(diags...)
+		appPackageType.Annotations, diags = mapMetadataValueToType(ctx, pkg.Metadata.Annotations)
+		diagnostics.Append(diags...)
+	} else {
+		appPackageType.Labels = types.MapNull(types.StringType)
+		appPackageType.Annotations = types.MapNull(types.StringType)
+	}
+
+	return appPackageType, diagnostics
+}
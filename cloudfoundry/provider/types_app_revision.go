@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type appRevisionType struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.Int64  `tfsdk:"version"`
+	Description types.String `tfsdk:"description"`
+	Droplet     types.String `tfsdk:"droplet"`
+	Deployable  types.Bool   `tfsdk:"deployable"`
+	Deployed    types.Bool   `tfsdk:"deployed"`
+	Environment types.Map    `tfsdk:"environment"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+type appRevisionsDatasourceType struct {
+	App       types.String      `tfsdk:"app"`
+	Deployed  types.Bool        `tfsdk:"deployed"`
+	Revisions []appRevisionType `tfsdk:"revisions"`
+}
+
+func mapAppRevisionValuesToType(ctx context.Context, value *resource.Revision, deployed bool, environment map[string]*string) (appRevisionType, diag.Diagnostics) {
+	var diagnostics, diags diag.Diagnostics
+	revisionType := appRevisionType{
+		ID:          types.StringValue(value.GUID),
+		Version:     types.Int64Value(int64(value.Version)),
+		Description: types.StringValue(value.Description),
+		Droplet:     types.StringValue(value.Droplet.GUID),
+		Deployable:  types.BoolValue(value.Deployable),
+		Deployed:    types.BoolValue(deployed),
+		CreatedAt:   types.StringValue(value.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:   types.StringValue(value.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	revisionType.Environment, diags = types.MapValueFrom(ctx, types.StringType, environment)
+	diagnostics.Append(diags...)
+	revisionType.Labels, diags = mapMetadataValueToType(ctx, value.Metadata.Labels)
+	diagnostics.Append(diags...)
+	revisionType.Annotations, diags = mapMetadataValueToType(ctx, value.Metadata.Annotations)
+	diagnostics.Append(diags...)
+
+	return revisionType, diagnostics
+}
+
+func mapAppRevisionsValuesToType(ctx context.Context, revisions []*resource.Revision, deployed map[string]bool, environments map[string]map[string]*string) ([]appRevisionType, diag.Diagnostics) {
+
+	var diagnostics diag.Diagnostics
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Version < revisions[j].Version
+	})
+	revisionsList := []appRevisionType{}
+	for _, revision := range revisions {
+		revisionValue, diags := mapAppRevisionValuesToType(ctx, revision, deployed[revision.GUID], environments[revision.GUID])
+		diagnostics.Append(diags...)
+		revisionsList = append(revisionsList, revisionValue)
+	}
+
+	return revisionsList, diagnostics
+}
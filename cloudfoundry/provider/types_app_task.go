@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	taskStateSucceeded = "SUCCEEDED"
+	taskStateFailed    = "FAILED"
+	taskStatePending   = "PENDING"
+	taskStateRunning   = "RUNNING"
+)
+
+// Terraform struct for storing values for app task resource.
+type AppTaskType struct {
+	Id                    types.String   `tfsdk:"id"`
+	App                   types.String   `tfsdk:"app"`
+	Name                  types.String   `tfsdk:"name"`
+	Command               types.String   `tfsdk:"command"`
+	Memory                types.String   `tfsdk:"memory"`
+	DiskQuota             types.String   `tfsdk:"disk_quota"`
+	LogRateLimitPerSecond types.String   `tfsdk:"log_rate_limit_per_second"`
+	Triggers              types.Map      `tfsdk:"triggers"`
+	SequenceId            types.Int64    `tfsdk:"sequence_id"`
+	State                 types.String   `tfsdk:"state"`
+	FailureReason         types.String   `tfsdk:"failure_reason"`
+	DropletGUID           types.String   `tfsdk:"droplet_guid"`
+	Labels                types.Map      `tfsdk:"labels"`
+	Annotations           types.Map      `tfsdk:"annotations"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	UpdatedAt             types.String   `tfsdk:"updated_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Sets the task resource values for creation with cf-client from the terraform struct values.
+func (data *AppTaskType) mapCreateAppTaskTypeToValues(ctx context.Context) (*cfv3resource.TaskCreate, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	createTask := cfv3resource.NewTaskCreateWithCommand(data.Command.ValueString())
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		createTask.WithName(data.Name.ValueString())
+	}
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
+		memory, err := convertToMegabytes(data.Memory.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error converting memory", err.Error())
+		}
+		createTask.WithMemoryInMB(memory)
+	}
+	if !data.DiskQuota.IsNull() && !data.DiskQuota.IsUnknown() {
+		disk, err := convertToMegabytes(data.DiskQuota.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error converting disk quota", err.Error())
+		}
+		createTask.WithDiskInMB(disk)
+	}
+	if !data.LogRateLimitPerSecond.IsNull() && !data.LogRateLimitPerSecond.IsUnknown() {
+		logRate, err := convertToBytes(data.LogRateLimitPerSecond.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error converting log_rate_limit_per_second", err.Error())
+		}
+		createTask.WithLogRateLimitInBytesPerSecond(logRate)
+	}
+
+	createTask.Metadata = cfv3resource.NewMetadata()
+	diagnostics.Append(data.Labels.ElementsAs(ctx, &createTask.Metadata.Labels, false)...)
+	diagnostics.Append(data.Annotations.ElementsAs(ctx, &createTask.Metadata.Annotations, false)...)
+
+	return createTask, diagnostics
+}
+
+// Sets the terraform struct values from the task resource returned by the cf-client.
+// The configured values are carried over from the plan so that unit notation chosen by the user is kept.
+func mapAppTaskValuesToType(ctx context.Context, task *cfv3resource.Task, plan AppTaskType) (AppTaskType, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	appTaskType := AppTaskType{
+		Id:          types.StringValue(task.GUID),
+		App:         types.StringValue(task.Relationships.App.Data.GUID),
+		Name:        types.StringValue(task.Name),
+		Command:     plan.Command,
+		Triggers:    plan.Triggers,
+		Timeouts:    plan.Timeouts,
+		SequenceId:  types.Int64Value(int64(task.SequenceID)),
+		State:       types.StringValue(task.State),
+		DropletGUID: types.StringValue(task.DropletGUID),
+		CreatedAt:   types.StringValue(task.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:   types.StringValue(task.UpdatedAt.Format(time.RFC3339)),
+	}
+	if task.Command != "" {
+		appTaskType.Command = types.StringValue(task.Command)
+	}
+
+	appTaskType.Memory, diags = mapTaskQuotaValueToType(fmt.Sprintf("%dM", task.MemoryInMB), plan.Memory)
+	diagnostics.Append(diags...)
+	appTaskType.DiskQuota, diags = mapTaskQuotaValueToType(fmt.Sprintf("%dM", task.DiskInMB), plan.DiskQuota)
+	diagnostics.Append(diags...)
+	logRateLimit := fmt.Sprintf("%dB", task.LogRateLimitInBytesPerSecond)
+	if task.LogRateLimitInBytesPerSecond == -1 {
+		logRateLimit = "-1"
+	}
+	appTaskType.LogRateLimitPerSecond, diags = mapTaskQuotaValueToType(logRateLimit, plan.LogRateLimitPerSecond)
+	diagnostics.Append(diags...)
+
+	if task.Result.FailureReason != nil {
+		appTaskType.FailureReason = types.StringValue(*task.Result.FailureReason)
+	} else {
+		appTaskType.FailureReason = types.StringNull()
+	}
+
+	if task.Metadata != nil {
+		appTaskType.Labels, diags = mapMetadataValueToType(ctx, task.Metadata.Labels)
+		diagnostics.Append(diags...)
+		appTaskType.Annotations, diags = mapMetadataValueToType(ctx, task.Metadata.Annotations)
+		diagnostics.Append(diags...)
+	} else {
+		appTaskType.Labels = types.MapNull(types.StringType)
+		appTaskType.Annotations = types.MapNull(types.StringType)
+	}
+
+	return appTaskType, diagnostics
+}
+
+// Keeps the planned notation (e.g. 1G) when it is equivalent to the value reported by the API (e.g. 1024M).
+func mapTaskQuotaValueToType(actual string, planned types.String) (types.String, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	if planned.IsNull() || planned.IsUnknown() {
+		return types.StringValue(actual), diagnostics
+	}
+	result, err := getDesiredType(actual, planned.ValueString())
+	if err != nil {
+		diagnostics.AddError("Error converting task quota", err.Error())
+		return types.StringValue(actual), diagnostics
+	}
+	return types.StringValue(result), diagnostics
+}
+
+// Converts a value with a unit like 1G or 512M to megabytes.
+func convertToMegabytes(value string) (int, error) {
+	result, _, err := convertToDesiredType(value, "1M")
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Floor(result)), nil
+}
+
+// Converts a value with a unit like 1K or 512B to bytes, -1 stands for unlimited.
+func convertToBytes(value string) (int, error) {
+	switch value {
+	case "-1":
+		return -1, nil
+	case "0":
+		return 0, nil
+	}
+	result, _, err := convertToDesiredType(value, "1B")
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Floor(result)), nil
+}
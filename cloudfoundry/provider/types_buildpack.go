@@ -5,25 +5,27 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type buildpackType struct {
-	Name           types.String `tfsdk:"name"`
-	Id             types.String `tfsdk:"id"`
-	Path           types.String `tfsdk:"path"`
-	State          types.String `tfsdk:"state"`
-	Stack          types.String `tfsdk:"stack"`
-	Filename       types.String `tfsdk:"filename"`
-	Position       types.Int64  `tfsdk:"position"`
-	Enabled        types.Bool   `tfsdk:"enabled"`
-	Locked         types.Bool   `tfsdk:"locked"`
-	Labels         types.Map    `tfsdk:"labels"`
-	Annotations    types.Map    `tfsdk:"annotations"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
-	SourceCodeHash types.String `tfsdk:"source_code_hash"`
+	Name           types.String   `tfsdk:"name"`
+	Id             types.String   `tfsdk:"id"`
+	Path           types.String   `tfsdk:"path"`
+	State          types.String   `tfsdk:"state"`
+	Stack          types.String   `tfsdk:"stack"`
+	Filename       types.String   `tfsdk:"filename"`
+	Position       types.Int64    `tfsdk:"position"`
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	Locked         types.Bool     `tfsdk:"locked"`
+	Labels         types.Map      `tfsdk:"labels"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	UpdatedAt      types.String   `tfsdk:"updated_at"`
+	SourceCodeHash types.String   `tfsdk:"source_code_hash"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type datasourceBuildpackType struct {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -23,6 +24,21 @@ type domainType struct {
 	UpdatedAt          types.String `tfsdk:"updated_at"`
 }
 
+type domainResourceType struct {
+	Name               types.String   `tfsdk:"name"`
+	Id                 types.String   `tfsdk:"id"`
+	Internal           types.Bool     `tfsdk:"internal"`
+	RouterGroup        types.String   `tfsdk:"router_group"`
+	Org                types.String   `tfsdk:"org"`
+	SharedOrgs         types.Set      `tfsdk:"shared_orgs"`
+	SupportedProtocols types.Set      `tfsdk:"supported_protocols"`
+	Labels             types.Map      `tfsdk:"labels"`
+	Annotations        types.Map      `tfsdk:"annotations"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
 type domainsDatasourceType struct {
 	Org     types.String `tfsdk:"org"`
 	Domains []domainType `tfsdk:"domains"`
@@ -60,7 +76,7 @@ func mapDomainValuesToType(ctx context.Context, domain *resource.Domain) (domain
 }
 
 // Sets the domain resource values for creation with cf-client from the terraform struct values.
-func (data *domainType) mapCreateDomainTypeToValues(ctx context.Context) (resource.DomainCreate, diag.Diagnostics) {
+func (data *domainResourceType) mapCreateDomainTypeToValues(ctx context.Context) (resource.DomainCreate, diag.Diagnostics) {
 
 	createDomain := resource.NewDomainCreate(data.Name.ValueString())
 
@@ -96,7 +112,7 @@ func (data *domainType) mapCreateDomainTypeToValues(ctx context.Context) (resour
 }
 
 // Sets the domain resource values for updation with cf-client from the terraform struct values.
-func (plan *domainType) mapUpdateDomainTypeToValues(ctx context.Context, state domainType) (resource.DomainUpdate, diag.Diagnostics) {
+func (plan *domainResourceType) mapUpdateDomainTypeToValues(ctx context.Context, state domainResourceType) (resource.DomainUpdate, diag.Diagnostics) {
 
 	updateDomain := &resource.DomainUpdate{}
 
@@ -118,3 +134,9 @@ func mapDomainsValuesToType(ctx context.Context, domains []*resource.Domain) ([]
 
 	return domainsList, diagnostics
 }
+
+func (a *domainType) Expand() domainResourceType {
+	var expanded domainResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type environmentVariableGroupType struct {
+	Id        types.String `tfsdk:"id"`
+	Type      types.String `tfsdk:"type"`
+	Variables types.Map    `tfsdk:"variables"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// The request body for updating an environment variable group, a nil value removes the variable from the group.
+type environmentVariableGroupUpdate struct {
+	Var map[string]*string `json:"var"`
+}
+
+// Sets the terraform struct values from the environment variable group returned by the cf-client.
+// Only the variables in managedVariables are taken over, unless no variables are managed yet e.g. after an import.
+func mapEnvironmentVariableGroupValuesToType(ctx context.Context, group *cfv3resource.EnvVarGroup, managedVariables types.Map) (environmentVariableGroupType, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	groupType := environmentVariableGroupType{
+		Id:        types.StringValue(group.Name),
+		Type:      types.StringValue(group.Name),
+		UpdatedAt: types.StringNull(),
+	}
+	if !group.UpdatedAt.IsZero() {
+		groupType.UpdatedAt = types.StringValue(group.UpdatedAt.Format(time.RFC3339))
+	}
+
+	variables := group.Var
+	if !managedVariables.IsNull() && !managedVariables.IsUnknown() {
+		variables = map[string]string{}
+		for key := range managedVariables.Elements() {
+			if value, ok := group.Var[key]; ok {
+				variables[key] = value
+			}
+		}
+	}
+	groupType.Variables, diags = types.MapValueFrom(ctx, types.StringType, variables)
+	diagnostics.Append(diags...)
+
+	return groupType, diagnostics
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"time"
+
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The values of the feature flags on a freshly installed Cloud Foundry, restored when a flag is no longer managed.
+var featureFlagDefaults = map[string]bool{
+	"app_bits_upload":    true,
+	"app_scaling":        true,
+	"diego_cnb":          false,
+	"diego_docker":       false,
+	"env_var_visibility": true,
+	"hide_marketplace_from_unauthenticated_users": false,
+	"private_domain_creation":                     true,
+	"resource_matching":                           true,
+	"route_creation":                              true,
+	"route_sharing":                               false,
+	"service_instance_creation":                   true,
+	"service_instance_sharing":                    false,
+	"set_roles_by_username":                       true,
+	"space_developer_env_var_visibility":          true,
+	"space_scoped_private_broker_creation":        true,
+	"task_creation":                               true,
+	"unset_roles_by_username":                     true,
+	"user_org_creation":                           false,
+}
+
+type featureFlagType struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	CustomErrorMessage types.String `tfsdk:"custom_error_message"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+type featureFlagsDatasourceType struct {
+	FeatureFlags []featureFlagType `tfsdk:"feature_flags"`
+}
+
+func mapFeatureFlagValuesToType(value *cfv3resource.FeatureFlag) featureFlagType {
+	featureFlag := featureFlagType{
+		Id:                 types.StringValue(value.Name),
+		Name:               types.StringValue(value.Name),
+		Enabled:            types.BoolValue(value.Enabled),
+		CustomErrorMessage: types.StringNull(),
+		UpdatedAt:          types.StringNull(),
+	}
+	// Flags which were never changed on the platform have no update timestamp.
+	if !value.UpdatedAt.IsZero() {
+		featureFlag.UpdatedAt = types.StringValue(value.UpdatedAt.Format(time.RFC3339))
+	}
+	if value.CustomErrorMessage != "" {
+		featureFlag.CustomErrorMessage = types.StringValue(value.CustomErrorMessage)
+	}
+	return featureFlag
+}
+
+func mapFeatureFlagsValuesToType(values []*cfv3resource.FeatureFlag) []featureFlagType {
+	featureFlags := []featureFlagType{}
+	for _, value := range values {
+		featureFlags = append(featureFlags, mapFeatureFlagValuesToType(value))
+	}
+	return featureFlags
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	appFeatureSSH               = "ssh"
+	appFeatureRevisions         = "revisions"
+	appFeatureServiceBindingK8s = "service-binding-k8s"
+	spaceFeatureSSH             = "ssh"
+)
+
+// The app features managed by the provider, in the order in which they are updated.
+var appFeatureNames = []string{appFeatureSSH, appFeatureRevisions, appFeatureServiceBindingK8s}
+
+type appFeaturesType struct {
+	Id                types.String `tfsdk:"id"`
+	App               types.String `tfsdk:"app"`
+	SSH               types.Bool   `tfsdk:"ssh"`
+	Revisions         types.Bool   `tfsdk:"revisions"`
+	ServiceBindingK8s types.Bool   `tfsdk:"service_binding_k8s"`
+}
+
+type spaceFeaturesType struct {
+	Id    types.String `tfsdk:"id"`
+	Space types.String `tfsdk:"space"`
+	SSH   types.Bool   `tfsdk:"ssh"`
+}
+
+// Returns the value of each app feature keyed by the feature name.
+func (data *appFeaturesType) featureValues() map[string]types.Bool {
+	return map[string]types.Bool{
+		appFeatureSSH:               data.SSH,
+		appFeatureRevisions:         data.Revisions,
+		appFeatureServiceBindingK8s: data.ServiceBindingK8s,
+	}
+}
+
+// Sets the terraform struct values from the app features returned by the cf-client.
+// Features which are not offered by the platform are set to null.
+func mapAppFeaturesValuesToType(appGUID string, features []*cfv3resource.AppFeature) appFeaturesType {
+	enabled := map[string]types.Bool{
+		appFeatureSSH:               types.BoolNull(),
+		appFeatureRevisions:         types.BoolNull(),
+		appFeatureServiceBindingK8s: types.BoolNull(),
+	}
+	for _, feature := range features {
+		if _, ok := enabled[feature.Name]; ok {
+			enabled[feature.Name] = types.BoolValue(feature.Enabled)
+		}
+	}
+
+	return appFeaturesType{
+		Id:                types.StringValue(appGUID),
+		App:               types.StringValue(appGUID),
+		SSH:               enabled[appFeatureSSH],
+		Revisions:         enabled[appFeatureRevisions],
+		ServiceBindingK8s: enabled[appFeatureServiceBindingK8s],
+	}
+}
+
+// Sets the terraform struct values from the space features returned by the cf-client.
+func mapSpaceFeaturesValuesToType(spaceGUID string, sshEnabled bool) spaceFeaturesType {
+	return spaceFeaturesType{
+		Id:    types.StringValue(spaceGUID),
+		Space: types.StringValue(spaceGUID),
+		SSH:   types.BoolValue(sshEnabled),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -112,3 +113,47 @@ func mapIsolationSegmentsValuesToType(ctx context.Context, isoSegs []*resource.I
 
 	return isoSegsList, diagnostics
 }
+
+// Terraform struct for storing values for org default isolation segment resource.
+type orgDefaultIsolationSegmentType struct {
+	Id           types.String `tfsdk:"id"`
+	Org          types.String `tfsdk:"org"`
+	Segment      types.String `tfsdk:"segment"`
+	AffectedApps types.Set    `tfsdk:"affected_apps"`
+}
+
+// Terraform struct for storing values for space isolation segment resource.
+type spaceIsolationSegmentType struct {
+	Id           types.String `tfsdk:"id"`
+	Space        types.String `tfsdk:"space"`
+	Segment      types.String `tfsdk:"segment"`
+	AffectedApps types.Set    `tfsdk:"affected_apps"`
+}
+
+// Sets the terraform struct values from the default isolation segment of an org and the apps affected by its assignment.
+func mapOrgDefaultIsolationSegmentValuesToType(orgGUID string, segmentGUID string, affectedApps types.Set) orgDefaultIsolationSegmentType {
+	return orgDefaultIsolationSegmentType{
+		Id:           types.StringValue(orgGUID),
+		Org:          types.StringValue(orgGUID),
+		Segment:      types.StringValue(segmentGUID),
+		AffectedApps: affectedAppsOrEmpty(affectedApps),
+	}
+}
+
+// Sets the terraform struct values from the isolation segment of a space and the apps affected by its assignment.
+func mapSpaceIsolationSegmentValuesToType(spaceGUID string, segmentGUID string, affectedApps types.Set) spaceIsolationSegmentType {
+	return spaceIsolationSegmentType{
+		Id:           types.StringValue(spaceGUID),
+		Space:        types.StringValue(spaceGUID),
+		Segment:      types.StringValue(segmentGUID),
+		AffectedApps: affectedAppsOrEmpty(affectedApps),
+	}
+}
+
+// The affected apps are only known for assignments done through terraform, e.g. not after an import.
+func affectedAppsOrEmpty(affectedApps types.Set) types.Set {
+	if affectedApps.IsNull() || affectedApps.IsUnknown() {
+		return types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	return affectedApps
+}
@@ -4,26 +4,28 @@ import (
 	"context"
 
 	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/mta"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type MtarType struct {
-	MtarPath                   types.String `tfsdk:"mtar_path"`
-	MtarUrl                    types.String `tfsdk:"mtar_url"`
-	ExtensionDescriptors       types.Set    `tfsdk:"extension_descriptors"`
-	ExtensionDescriptorsString types.Set    `tfsdk:"extension_descriptors_string"`
-	DeployUrl                  types.String `tfsdk:"deploy_url"`
-	Space                      types.String `tfsdk:"space"`
-	Mta                        types.Object `tfsdk:"mta"`
-	Namespace                  types.String `tfsdk:"namespace"`
-	Id                         types.String `tfsdk:"id"`
-	SourceCodeHash             types.String `tfsdk:"source_code_hash"`
-	DeployStrategy             types.String `tfsdk:"deploy_strategy"`
-	SkipIdleStart              types.Bool   `tfsdk:"skip_idle_start"`
-	VersionRule                types.String `tfsdk:"version_rule"`
-	Modules                    types.Set    `tfsdk:"modules"`
+	MtarPath                   types.String   `tfsdk:"mtar_path"`
+	MtarUrl                    types.String   `tfsdk:"mtar_url"`
+	ExtensionDescriptors       types.Set      `tfsdk:"extension_descriptors"`
+	ExtensionDescriptorsString types.Set      `tfsdk:"extension_descriptors_string"`
+	DeployUrl                  types.String   `tfsdk:"deploy_url"`
+	Space                      types.String   `tfsdk:"space"`
+	Mta                        types.Object   `tfsdk:"mta"`
+	Namespace                  types.String   `tfsdk:"namespace"`
+	Id                         types.String   `tfsdk:"id"`
+	SourceCodeHash             types.String   `tfsdk:"source_code_hash"`
+	DeployStrategy             types.String   `tfsdk:"deploy_strategy"`
+	SkipIdleStart              types.Bool     `tfsdk:"skip_idle_start"`
+	VersionRule                types.String   `tfsdk:"version_rule"`
+	Modules                    types.Set      `tfsdk:"modules"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 type MtasDataSourceType struct {
@@ -177,6 +179,11 @@ func mapMtaToResourceType(ctx context.Context, m mta.Mta, space types.String, na
 	mtarType.ExtensionDescriptors = types.SetNull(types.StringType)
 	mtarType.ExtensionDescriptorsString = types.SetNull(types.StringType)
 	mtarType.Modules = types.SetNull(types.StringType)
+	mtarType.Timeouts = timeoutsNull(timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
 
 	return mtarType, diagnostics
 }
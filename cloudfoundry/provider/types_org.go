@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -20,6 +21,18 @@ type orgType struct {
 	Quota       types.String `tfsdk:"quota"`
 }
 
+type orgResourceType struct {
+	Name        types.String   `tfsdk:"name"`
+	ID          types.String   `tfsdk:"id"`
+	Labels      types.Map      `tfsdk:"labels"`
+	Annotations types.Map      `tfsdk:"annotations"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Suspended   types.Bool     `tfsdk:"suspended"`
+	Quota       types.String   `tfsdk:"quota"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
 type orgsType struct {
 	Name types.String `tfsdk:"name"`
 	Orgs []orgType    `tfsdk:"orgs"`
@@ -43,6 +56,12 @@ func mapOrgValuesToType(ctx context.Context, value *resource.Organization) (orgT
 	return orgType, diagnostics
 }
 
+func (a *orgType) Expand() orgResourceType {
+	var expanded orgResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
+
 func mapOrgsValuesToType(ctx context.Context, orgs []*resource.Organization) ([]orgType, diag.Diagnostics) {
 	var diagnostics diag.Diagnostics
 
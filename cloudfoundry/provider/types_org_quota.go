@@ -5,6 +5,7 @@ import (
 	"time"
 
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -28,12 +29,32 @@ type OrgQuotaType struct {
 	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
+type orgQuotaResourceType struct {
+	Name                  types.String   `tfsdk:"name"`
+	ID                    types.String   `tfsdk:"id"`
+	AllowPaidServicePlans types.Bool     `tfsdk:"allow_paid_service_plans"`
+	TotalServices         types.Int64    `tfsdk:"total_services"`
+	TotalServiceKeys      types.Int64    `tfsdk:"total_service_keys"`
+	TotalRoutes           types.Int64    `tfsdk:"total_routes"`
+	TotalRoutePorts       types.Int64    `tfsdk:"total_route_ports"`
+	TotalPrivateDomains   types.Int64    `tfsdk:"total_private_domains"`
+	TotalMemory           types.Int64    `tfsdk:"total_memory"`
+	InstanceMemory        types.Int64    `tfsdk:"instance_memory"`
+	TotalAppInstances     types.Int64    `tfsdk:"total_app_instances"`
+	TotalAppTasks         types.Int64    `tfsdk:"total_app_tasks"`
+	TotalAppLogRateLimit  types.Int64    `tfsdk:"total_app_log_rate_limit"`
+	Organizations         types.Set      `tfsdk:"orgs"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	UpdatedAt             types.String   `tfsdk:"updated_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
 type orgQuotasDatasourceType struct {
 	Org       types.String   `tfsdk:"org"`
 	OrgQuotas []OrgQuotaType `tfsdk:"org_quotas"`
 }
 
-func (orgQuotaType *OrgQuotaType) mapOrgQuotaTypeToValues(ctx context.Context) (*cfv3resource.OrganizationQuotaCreateOrUpdate, diag.Diagnostics) {
+func (orgQuotaType *orgQuotaResourceType) mapOrgQuotaTypeToValues(ctx context.Context) (*cfv3resource.OrganizationQuotaCreateOrUpdate, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	orgQuota := cfv3resource.NewOrganizationQuotaCreate(orgQuotaType.Name.ValueString())
 	orgQuota.Domains = &cfv3resource.DomainsQuota{}
@@ -134,3 +155,9 @@ func mapOrgQuotasValuesToType(orgQuotas []*cfv3resource.OrganizationQuota) ([]Or
 
 	return orgQuotasList, diagnostics
 }
+
+func (a *OrgQuotaType) Expand() orgQuotaResourceType {
+	var expanded orgQuotaResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,25 +21,27 @@ type roleType struct {
 }
 
 type spaceRoleType struct {
-	Type      types.String `tfsdk:"type"`
-	User      types.String `tfsdk:"user"`
-	UserName  types.String `tfsdk:"username"`
-	Origin    types.String `tfsdk:"origin"`
-	Space     types.String `tfsdk:"space"`
-	Id        types.String `tfsdk:"id"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	Type      types.String   `tfsdk:"type"`
+	User      types.String   `tfsdk:"user"`
+	UserName  types.String   `tfsdk:"username"`
+	Origin    types.String   `tfsdk:"origin"`
+	Space     types.String   `tfsdk:"space"`
+	Id        types.String   `tfsdk:"id"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 type orgRoleType struct {
-	Type         types.String `tfsdk:"type"`
-	User         types.String `tfsdk:"user"`
-	UserName     types.String `tfsdk:"username"`
-	Origin       types.String `tfsdk:"origin"`
-	Id           types.String `tfsdk:"id"`
-	Organization types.String `tfsdk:"org"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
+	Type         types.String   `tfsdk:"type"`
+	User         types.String   `tfsdk:"user"`
+	UserName     types.String   `tfsdk:"username"`
+	Origin       types.String   `tfsdk:"origin"`
+	Id           types.String   `tfsdk:"id"`
+	Organization types.String   `tfsdk:"org"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type spaceRoleDatasourceType struct {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,23 @@ type routeType struct {
 	Annotations  types.Map    `tfsdk:"annotations"`
 }
 
+type routeResourceType struct {
+	Protocol     types.String   `tfsdk:"protocol"`
+	Host         types.String   `tfsdk:"host"`
+	Path         types.String   `tfsdk:"path"`
+	Port         types.Int64    `tfsdk:"port"`
+	Url          types.String   `tfsdk:"url"`
+	Id           types.String   `tfsdk:"id"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	Destinations types.Set      `tfsdk:"destinations"`
+	Space        types.String   `tfsdk:"space"`
+	Domain       types.String   `tfsdk:"domain"`
+	Labels       types.Map      `tfsdk:"labels"`
+	Annotations  types.Map      `tfsdk:"annotations"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
 type datasourceRouteType struct {
 	Space  types.String `tfsdk:"space"`
 	Domain types.String `tfsdk:"domain"`
@@ -188,7 +206,7 @@ func (data *datasourceRouteType) mapReadRouteTypeToValues() client.RouteListOpti
 }
 
 // Sets the route resource values for creation with cf-client from the terraform struct values.
-func (data *routeType) mapCreateRouteTypeToValues(ctx context.Context) (resource.RouteCreate, diag.Diagnostics) {
+func (data *routeResourceType) mapCreateRouteTypeToValues(ctx context.Context) (resource.RouteCreate, diag.Diagnostics) {
 
 	routeCreate := resource.NewRouteCreate(data.Domain.ValueString(), data.Space.ValueString())
 	if !data.Host.IsNull() {
@@ -214,7 +232,7 @@ func (data *routeType) mapCreateRouteTypeToValues(ctx context.Context) (resource
 }
 
 // Sets the destinations resource values for creation with cf-client from the terraform struct values.
-func (data *routeType) mapCreateDestinationsTypeToValues(ctx context.Context) ([]*resource.RouteDestinationInsertOrReplace, diag.Diagnostics) {
+func (data *routeResourceType) mapCreateDestinationsTypeToValues(ctx context.Context) ([]*resource.RouteDestinationInsertOrReplace, diag.Diagnostics) {
 	var (
 		destinations       []destinationType
 		diags, diagnostics diag.Diagnostics
@@ -263,7 +281,7 @@ func mapTypetoDestinationValues(destination destinationType) *resource.RouteDest
 }
 
 // Sets the route resource values for updation with cf-client from the terraform struct values.
-func (plan *routeType) mapUpdateRouteTypeToValues(ctx context.Context, state *routeType) (resource.RouteUpdate, diag.Diagnostics) {
+func (plan *routeResourceType) mapUpdateRouteTypeToValues(ctx context.Context, state *routeResourceType) (resource.RouteUpdate, diag.Diagnostics) {
 	routeUpdate := resource.RouteUpdate{}
 
 	var diagnostics diag.Diagnostics
@@ -334,3 +352,9 @@ func ReComputeStringValue() planmodifier.String {
 func ReComputeIntValue() planmodifier.Int64 {
 	return computeValueModifier{}
 }
+
+func (a *routeType) Expand() routeResourceType {
+	var expanded routeResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +24,19 @@ type securityGroupType struct {
 	UpdatedAt              types.String `tfsdk:"updated_at"`
 }
 
+type securityGroupResourceType struct {
+	Name                   types.String   `tfsdk:"name"`
+	Rules                  types.List     `tfsdk:"rules"`
+	GloballyEnabledRunning types.Bool     `tfsdk:"globally_enabled_running"`
+	GloballyEnabledStaging types.Bool     `tfsdk:"globally_enabled_staging"`
+	RunningSpaces          types.Set      `tfsdk:"running_spaces"`
+	StagingSpaces          types.Set      `tfsdk:"staging_spaces"`
+	Id                     types.String   `tfsdk:"id"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	UpdatedAt              types.String   `tfsdk:"updated_at"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
 type securityGroupsType struct {
 	Name           types.String        `tfsdk:"name"`
 	RunningSpace   types.String        `tfsdk:"running_space"`
@@ -130,7 +144,7 @@ func mapRuleValuesToListType(ctx context.Context, rules *[]resource.SecurityGrou
 }
 
 // Sets the security group resource values for creation with cf-client from the terraform struct values.
-func (data *securityGroupType) mapCreateSecurityGroupTypeToValues(ctx context.Context) (resource.SecurityGroupCreate, diag.Diagnostics) {
+func (data *securityGroupResourceType) mapCreateSecurityGroupTypeToValues(ctx context.Context) (resource.SecurityGroupCreate, diag.Diagnostics) {
 
 	createSecurityGroup := &resource.SecurityGroupCreate{Name: data.Name.ValueString()}
 
@@ -210,7 +224,7 @@ func mapTypetoRuleValues(rule ruleType) *resource.SecurityGroupRule {
 }
 
 // Sets the security group resource values for updation with cf-client from the terraform struct values.
-func (plan *securityGroupType) mapUpdateSecurityGroupTypeToValues(ctx context.Context) (resource.SecurityGroupUpdate, diag.Diagnostics) {
+func (plan *securityGroupResourceType) mapUpdateSecurityGroupTypeToValues(ctx context.Context) (resource.SecurityGroupUpdate, diag.Diagnostics) {
 
 	updateSecurityGroup := &resource.SecurityGroupUpdate{
 		Name: plan.Name.ValueString(),
@@ -279,3 +293,9 @@ func (plan *securityGroupSpacesType) mapSecurityGroupSpacesValuestoType(ctx cont
 
 	return diagnostics
 }
+
+func (a *securityGroupType) Expand() securityGroupResourceType {
+	var expanded securityGroupResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
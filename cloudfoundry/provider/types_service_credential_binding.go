@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -32,6 +35,35 @@ type serviceCredentialBindingTypeWithCredentials struct {
 	UpdatedAt       types.String         `tfsdk:"updated_at"`
 }
 
+// Terraform struct for storing values for the service credential binding resource.
+type serviceCredentialBindingResourceType struct {
+	Name            types.String         `tfsdk:"name"`
+	ID              types.String         `tfsdk:"id"`
+	Type            types.String         `tfsdk:"type"`
+	ServiceInstance types.String         `tfsdk:"service_instance"`
+	Credentials     jsontypes.Normalized `tfsdk:"credential_binding"`
+	Parameters      jsontypes.Normalized `tfsdk:"parameters"`
+	App             types.String         `tfsdk:"app"`
+	RotationTrigger types.String         `tfsdk:"rotation_trigger"`
+	RotationRollout types.String         `tfsdk:"rotation_rollout"`
+	LastOperation   types.Object         `tfsdk:"last_operation"` //LastOperationType
+	Labels          types.Map            `tfsdk:"labels"`
+	Annotations     types.Map            `tfsdk:"annotations"`
+	CreatedAt       types.String         `tfsdk:"created_at"`
+	UpdatedAt       types.String         `tfsdk:"updated_at"`
+	Timeouts        timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// Terraform struct for storing values for the service credential binding ephemeral resource.
+type serviceCredentialBindingEphemeralType struct {
+	Id              types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Type            types.String         `tfsdk:"type"`
+	ServiceInstance types.String         `tfsdk:"service_instance"`
+	App             types.String         `tfsdk:"app"`
+	Credentials     jsontypes.Normalized `tfsdk:"credential_binding"`
+}
+
 func mapServiceCredentialBindingValuesToType(ctx context.Context, value *resource.ServiceCredentialBinding) (serviceCredentialBindingTypeWithCredentials, diag.Diagnostics) {
 	var diags, diagnostics diag.Diagnostics
 	serviceCredentialBindingType := serviceCredentialBindingTypeWithCredentials{
@@ -60,7 +92,33 @@ func mapServiceCredentialBindingValuesToType(ctx context.Context, value *resourc
 	return serviceCredentialBindingType, diagnostics
 }
 
-func (data *serviceCredentialBindingTypeWithCredentials) mapCreateServiceCredentialBindingTypeToValues(ctx context.Context) (resource.ServiceCredentialBindingCreate, diag.Diagnostics) {
+func (a *serviceCredentialBindingTypeWithCredentials) Expand() serviceCredentialBindingResourceType {
+	var expanded serviceCredentialBindingResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
+
+// Reports whether the planned rotation trigger differs from the one in state and the binding has to be replaced
+// by a new one.
+func (plan *serviceCredentialBindingResourceType) isRotationRequested(state serviceCredentialBindingResourceType) bool {
+	if plan.RotationTrigger.IsUnknown() {
+		return true
+	}
+	return !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+// Returns the name of the service key on the platform. Names of service keys are unique per service instance, so the
+// name of a key with a rotation trigger gets a suffix derived from the trigger, which lets the rotated key be created
+// next to the old one.
+func (data *serviceCredentialBindingResourceType) keyName() string {
+	if data.RotationTrigger.IsNull() || data.RotationTrigger.IsUnknown() {
+		return data.Name.ValueString()
+	}
+	sum := sha256.Sum256([]byte(data.RotationTrigger.ValueString()))
+	return data.Name.ValueString() + "-" + hex.EncodeToString(sum[:4])
+}
+
+func (data *serviceCredentialBindingResourceType) mapCreateServiceCredentialBindingTypeToValues(ctx context.Context) (resource.ServiceCredentialBindingCreate, diag.Diagnostics) {
 
 	var (
 		diagnostics                    diag.Diagnostics
@@ -73,7 +131,7 @@ func (data *serviceCredentialBindingTypeWithCredentials) mapCreateServiceCredent
 			createServiceCredentialBinding.WithName(data.Name.ValueString())
 		}
 	case keyServiceCredentialBinding:
-		createServiceCredentialBinding = resource.NewServiceCredentialBindingCreateKey(data.ServiceInstance.ValueString(), data.Name.ValueString())
+		createServiceCredentialBinding = resource.NewServiceCredentialBindingCreateKey(data.ServiceInstance.ValueString(), data.keyName())
 	}
 
 	if !data.Parameters.IsNull() {
@@ -89,7 +147,7 @@ func (data *serviceCredentialBindingTypeWithCredentials) mapCreateServiceCredent
 	return *createServiceCredentialBinding, diagnostics
 }
 
-func (plan *serviceCredentialBindingTypeWithCredentials) mapUpdateServiceCredentialBindingTypeToValues(ctx context.Context, state serviceCredentialBindingTypeWithCredentials) (resource.ServiceCredentialBindingUpdate, diag.Diagnostics) {
+func (plan *serviceCredentialBindingResourceType) mapUpdateServiceCredentialBindingTypeToValues(ctx context.Context, state serviceCredentialBindingResourceType) (resource.ServiceCredentialBindingUpdate, diag.Diagnostics) {
 
 	updateCredBinding := &resource.ServiceCredentialBindingUpdate{}
 
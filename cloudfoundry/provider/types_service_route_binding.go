@@ -7,6 +7,7 @@ import (
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -22,6 +23,7 @@ type serviceRouteBindingType struct {
 	CreatedAt       types.String         `tfsdk:"created_at"`
 	UpdatedAt       types.String         `tfsdk:"updated_at"`
 	LastOperation   types.Object         `tfsdk:"last_operation"` //LastOperationType
+	Timeouts        timeouts.Value       `tfsdk:"timeouts"`
 }
 
 type datasourceserviceRouteBindingType struct {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -24,6 +25,20 @@ type spaceType struct {
 	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
 
+type spaceResourceType struct {
+	Name             types.String   `tfsdk:"name"`
+	Id               types.String   `tfsdk:"id"`
+	OrgId            types.String   `tfsdk:"org"`
+	Quota            types.String   `tfsdk:"quota"`
+	AllowSSH         types.Bool     `tfsdk:"allow_ssh"`
+	IsolationSegment types.String   `tfsdk:"isolation_segment"`
+	Labels           types.Map      `tfsdk:"labels"`
+	Annotations      types.Map      `tfsdk:"annotations"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
 // Terraform struct for storing values for spaces data.
 type spacesType struct {
 	Name   types.String `tfsdk:"name"`
@@ -32,7 +47,7 @@ type spacesType struct {
 }
 
 // Sets the space resource values for creation with cf-client from the terraform struct values.
-func (data *spaceType) mapCreateSpaceTypeToValues(ctx context.Context) (resource.SpaceCreate, diag.Diagnostics) {
+func (data *spaceResourceType) mapCreateSpaceTypeToValues(ctx context.Context) (resource.SpaceCreate, diag.Diagnostics) {
 
 	createSpace := resource.NewSpaceCreate(data.Name.ValueString(), data.OrgId.ValueString())
 	var diagnostics diag.Diagnostics
@@ -48,7 +63,7 @@ func (data *spaceType) mapCreateSpaceTypeToValues(ctx context.Context) (resource
 }
 
 // Sets the space resource values for updation with cf-client from the terraform struct values.
-func (plan *spaceType) mapUpdateSpaceTypeToValues(ctx context.Context, state spaceType) (resource.SpaceUpdate, diag.Diagnostics) {
+func (plan *spaceResourceType) mapUpdateSpaceTypeToValues(ctx context.Context, state spaceResourceType) (resource.SpaceUpdate, diag.Diagnostics) {
 
 	updateSpace := &resource.SpaceUpdate{
 		Name: plan.Name.ValueString(),
@@ -89,3 +104,9 @@ func mapSpaceValuesToType(ctx context.Context, space *resource.Space, sshEnabled
 
 	return spaceType, diagnostics
 }
+
+func (a *spaceType) Expand() spaceResourceType {
+	var expanded spaceResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
@@ -29,13 +30,33 @@ type spaceQuotaType struct {
 	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
+type spaceQuotaResourceType struct {
+	Name                  types.String   `tfsdk:"name"`
+	ID                    types.String   `tfsdk:"id"`
+	AllowPaidServicePlans types.Bool     `tfsdk:"allow_paid_service_plans"`
+	TotalServices         types.Int64    `tfsdk:"total_services"`
+	TotalServiceKeys      types.Int64    `tfsdk:"total_service_keys"`
+	TotalRoutes           types.Int64    `tfsdk:"total_routes"`
+	TotalRoutePorts       types.Int64    `tfsdk:"total_route_ports"`
+	TotalMemory           types.Int64    `tfsdk:"total_memory"`
+	InstanceMemory        types.Int64    `tfsdk:"instance_memory"`
+	TotalAppInstances     types.Int64    `tfsdk:"total_app_instances"`
+	TotalAppTasks         types.Int64    `tfsdk:"total_app_tasks"`
+	TotalAppLogRateLimit  types.Int64    `tfsdk:"total_app_log_rate_limit"`
+	Spaces                types.Set      `tfsdk:"spaces"`
+	Org                   types.String   `tfsdk:"org"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	UpdatedAt             types.String   `tfsdk:"updated_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
 type spaceQuotasDatasourceType struct {
 	Name        types.String     `tfsdk:"name"`
 	Org         types.String     `tfsdk:"org"`
 	SpaceQuotas []spaceQuotaType `tfsdk:"space_quotas"`
 }
 
-func (spaceQuotaType *spaceQuotaType) mapSpaceQuotaTypeToValues(ctx context.Context) (*cfv3resource.SpaceQuotaCreateOrUpdate, diag.Diagnostics) {
+func (spaceQuotaType *spaceQuotaResourceType) mapSpaceQuotaTypeToValues(ctx context.Context) (*cfv3resource.SpaceQuotaCreateOrUpdate, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	spaceQuota := cfv3resource.NewSpaceQuotaCreate(spaceQuotaType.Name.ValueString(), spaceQuotaType.Org.ValueString())
 	spaceQuota.Apps = &cfv3resource.AppsQuota{}
@@ -150,3 +171,9 @@ func (plan *spaceQuotaType) mapSpaceQuotaAssignmentValuestoType(ctx context.Cont
 	}
 	return diagnostics
 }
+
+func (a *spaceQuotaType) Expand() spaceQuotaResourceType {
+	var expanded spaceQuotaResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudfoundry-community/go-uaa"
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -23,19 +24,32 @@ type userType struct {
 	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
 
+type userCFResourceType struct {
+	UserName         types.String   `tfsdk:"username"`
+	Origin           types.String   `tfsdk:"origin"`
+	Id               types.String   `tfsdk:"id"`
+	Labels           types.Map      `tfsdk:"labels"`
+	PresentationName types.String   `tfsdk:"presentation_name"`
+	Annotations      types.Map      `tfsdk:"annotations"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
 type userResourceType struct {
-	Password    types.String `tfsdk:"password"`
-	GivenName   types.String `tfsdk:"given_name"`
-	FamilyName  types.String `tfsdk:"family_name"`
-	UserName    types.String `tfsdk:"username"`
-	Origin      types.String `tfsdk:"origin"`
-	Email       types.String `tfsdk:"email"`
-	Groups      types.Set    `tfsdk:"groups"`
-	Id          types.String `tfsdk:"id"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Password    types.String   `tfsdk:"password"`
+	GivenName   types.String   `tfsdk:"given_name"`
+	FamilyName  types.String   `tfsdk:"family_name"`
+	UserName    types.String   `tfsdk:"username"`
+	Origin      types.String   `tfsdk:"origin"`
+	Email       types.String   `tfsdk:"email"`
+	Groups      types.Set      `tfsdk:"groups"`
+	Id          types.String   `tfsdk:"id"`
+	Labels      types.Map      `tfsdk:"labels"`
+	Annotations types.Map      `tfsdk:"annotations"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type datasourceUserType struct {
@@ -275,3 +289,9 @@ func mapUserCFValuesToResourceType(ctx context.Context, user *resource.User) (us
 
 	return userResourceType, diagnostics
 }
+
+func (a *userType) Expand() userCFResourceType {
+	var expanded userCFResourceType
+	copyFields(&expanded, a)
+	return expanded
+}
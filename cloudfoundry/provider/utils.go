@@ -8,6 +8,8 @@ import (
 
 	cfv3client "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfv3resource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/cloudfoundry/provider/managers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,6 +33,9 @@ const (
 
 const defaultTimeout = 20 * time.Minute
 
+// MTA deployments push several apps and create services in one operation and therefore get a much larger default.
+const defaultMtaTimeout = 3 * time.Hour
+
 func datasourceLabelsSchema() *schema.MapAttribute {
 	return &schema.MapAttribute{
 		MarkdownDescription: "The labels associated with Cloud Foundry resources.",
@@ -94,6 +99,52 @@ func guidSchema() *schema.StringAttribute {
 	}
 }
 
+// Returns the timeouts of the given operations of a resource which waits for asynchronous jobs of the platform.
+func timeoutsSchema(ctx context.Context, resourceName string, opts timeouts.Opts) schema.Attribute {
+	return timeoutsSchemaWithDefault(ctx, resourceName, opts, defaultTimeout)
+}
+
+// Returns the timeouts of the given operations of a resource whose operations take longer than the default timeout.
+func timeoutsSchemaWithDefault(ctx context.Context, resourceName string, opts timeouts.Opts, defaultValue time.Duration) schema.Attribute {
+	description := func(operation string) string {
+		return fmt.Sprintf("Timeout for %s the %s. Default is %d minutes", operation, resourceName, int(defaultValue.Minutes()))
+	}
+	if opts.Create {
+		opts.CreateDescription = description("creating")
+	}
+	if opts.Update {
+		opts.UpdateDescription = description("updating")
+	}
+	if opts.Delete {
+		opts.DeleteDescription = description("deleting")
+	}
+	return timeouts.Attributes(ctx, opts)
+}
+
+// Reports whether a timeout is configured for the given operation, i.e. "create", "update" or "delete".
+func timeoutConfigured(value timeouts.Value, operation string) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	timeout, ok := value.Attributes()[operation]
+	return ok && !timeout.IsNull() && !timeout.IsUnknown()
+}
+
+// Returns a null value for the timeouts of the given operations, e.g. for resources which are listed or imported.
+func timeoutsNull(opts timeouts.Opts) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	if opts.Create {
+		attrTypes["create"] = types.StringType
+	}
+	if opts.Update {
+		attrTypes["update"] = types.StringType
+	}
+	if opts.Delete {
+		attrTypes["delete"] = types.StringType
+	}
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
+
 func lastOperationSchema() *schema.SingleNestedAttribute {
 	return &schema.SingleNestedAttribute{
 		MarkdownDescription: "The details of the last operation performed on the resource",
@@ -185,6 +236,205 @@ func pollJob(ctx context.Context, client cfv3client.Client, jobID string, timeou
 	})
 }
 
+// Blocks for the given duration or until the context is done.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Waits for a task to reach a terminal state and returns the last observed task.
+func pollTask(ctx context.Context, client cfv3client.Client, taskID string, timeout time.Duration) (*cfv3resource.Task, error) {
+	var task *cfv3resource.Task
+	err := cfv3client.PollForStateOrTimeout(func() (string, string, error) {
+		var err error
+		task, err = client.Tasks.Get(ctx, taskID)
+		if err != nil {
+			return "", "", err
+		}
+		failureReason := ""
+		if task.Result.FailureReason != nil {
+			failureReason = *task.Result.FailureReason
+		}
+		return task.State, failureReason, nil
+	}, taskStateSucceeded, &cfv3client.PollingOptions{
+		Timeout:       timeout,
+		CheckInterval: time.Second * 2,
+		FailedState:   taskStateFailed,
+	})
+	return task, err
+}
+
+// Waits for a droplet to be staged e.g. after it was copied or uploaded and returns the staged droplet.
+func pollDroplet(ctx context.Context, client cfv3client.Client, dropletID string, timeout time.Duration) (*cfv3resource.Droplet, error) {
+	var droplet *cfv3resource.Droplet
+	err := cfv3client.PollForStateOrTimeout(func() (string, string, error) {
+		var err error
+		droplet, err = client.Droplets.Get(ctx, dropletID)
+		if err != nil {
+			return "", "", err
+		}
+		dropletError := ""
+		if droplet.Error != nil {
+			dropletError = *droplet.Error
+		}
+		return string(droplet.State), dropletError, nil
+	}, dropletStateStaged, &cfv3client.PollingOptions{
+		Timeout:       timeout,
+		CheckInterval: time.Second * 2,
+		FailedState:   dropletStateFailed,
+	})
+	return droplet, err
+}
+
+// Lists the GUIDs of the started apps in the given spaces, these apps have to be restarted to pick up a new isolation segment.
+func listStartedApps(ctx context.Context, client cfv3client.Client, spaceGUIDs []string) ([]string, error) {
+	startedApps := []string{}
+	if len(spaceGUIDs) == 0 {
+		return startedApps, nil
+	}
+	appOpts := cfv3client.NewAppListOptions()
+	appOpts.SpaceGUIDs = cfv3client.Filter{
+		Values: spaceGUIDs,
+	}
+	apps, err := client.Applications.ListAll(ctx, appOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps {
+		if app.State == "STARTED" {
+			startedApps = append(startedApps, app.GUID)
+		}
+	}
+	return startedApps, nil
+}
+
+// nameLookup resolves a resource by its name. Only the GUID the name resolves to is shared via the lookup cache under
+// the kind and key, so that data sources and resources which look up the same name share one kind per resource type.
+type nameLookup[T any] struct {
+	kind string
+	key  string
+	list func(ctx context.Context) (*T, error)
+	get  func(ctx context.Context, guid string) (*T, error)
+	guid func(value *T) string
+}
+
+// Returns the GUID of the resource and the resource itself if it had to be listed, nil if the GUID was cached.
+func (l nameLookup[T]) resolve(ctx context.Context, lookups *managers.LookupCache) (string, *T, error) {
+	var listed *T
+	guid, err := managers.Lookup(ctx, lookups, l.kind, l.key, func() (string, error) {
+		value, err := l.list(ctx)
+		if err != nil {
+			return "", err
+		}
+		listed = value
+		return l.guid(value), nil
+	})
+	return guid, listed, err
+}
+
+// Returns the resource, which is read again by its GUID if the name was served from the lookup cache.
+func (l nameLookup[T]) read(ctx context.Context, lookups *managers.LookupCache) (*T, error) {
+	guid, listed, err := l.resolve(ctx, lookups)
+	if err != nil || listed != nil {
+		return listed, err
+	}
+	return l.get(ctx, guid)
+}
+
+func orgLookup(client *cfv3client.Client, name string) nameLookup[cfv3resource.Organization] {
+	return nameLookup[cfv3resource.Organization]{
+		kind: "org",
+		key:  name,
+		list: func(ctx context.Context) (*cfv3resource.Organization, error) {
+			opts := cfv3client.NewOrganizationListOptions()
+			opts.Names = cfv3client.Filter{Values: []string{name}}
+			return client.Organizations.Single(ctx, opts)
+		},
+		get:  client.Organizations.Get,
+		guid: func(org *cfv3resource.Organization) string { return org.GUID },
+	}
+}
+
+func spaceLookup(client *cfv3client.Client, orgGUID string, name string) nameLookup[cfv3resource.Space] {
+	return nameLookup[cfv3resource.Space]{
+		kind: "space",
+		key:  orgGUID + "/" + name,
+		list: func(ctx context.Context) (*cfv3resource.Space, error) {
+			opts := cfv3client.NewSpaceListOptions()
+			opts.Names = cfv3client.Filter{Values: []string{name}}
+			opts.OrganizationGUIDs = cfv3client.Filter{Values: []string{orgGUID}}
+			return client.Spaces.Single(ctx, opts)
+		},
+		get:  client.Spaces.Get,
+		guid: func(space *cfv3resource.Space) string { return space.GUID },
+	}
+}
+
+func domainLookup(client *cfv3client.Client, name string) nameLookup[cfv3resource.Domain] {
+	return nameLookup[cfv3resource.Domain]{
+		kind: "domain",
+		key:  name,
+		list: func(ctx context.Context) (*cfv3resource.Domain, error) {
+			opts := cfv3client.NewDomainListOptions()
+			opts.Names = cfv3client.Filter{Values: []string{name}}
+			return client.Domains.Single(ctx, opts)
+		},
+		get:  client.Domains.Get,
+		guid: func(domain *cfv3resource.Domain) string { return domain.GUID },
+	}
+}
+
+func stackLookup(client *cfv3client.Client, name string) nameLookup[cfv3resource.Stack] {
+	return nameLookup[cfv3resource.Stack]{
+		kind: "stack",
+		key:  name,
+		list: func(ctx context.Context) (*cfv3resource.Stack, error) {
+			opts := cfv3client.NewStackListOptions()
+			opts.Names = cfv3client.Filter{Values: []string{name}}
+			return client.Stacks.Single(ctx, opts)
+		},
+		get:  client.Stacks.Get,
+		guid: func(stack *cfv3resource.Stack) string { return stack.GUID },
+	}
+}
+
+// The service broker name is optional, plans looked up without it share the key with each other only.
+func servicePlanLookup(client *cfv3client.Client, brokerName string, offeringName string, name string) nameLookup[cfv3resource.ServicePlan] {
+	return nameLookup[cfv3resource.ServicePlan]{
+		kind: "service_plan",
+		key:  brokerName + "/" + offeringName + "/" + name,
+		list: func(ctx context.Context) (*cfv3resource.ServicePlan, error) {
+			opts := cfv3client.NewServicePlanListOptions()
+			opts.Names = cfv3client.Filter{Values: []string{name}}
+			opts.ServiceOfferingNames = cfv3client.Filter{Values: []string{offeringName}}
+			if brokerName != "" {
+				opts.ServiceBrokerNames = cfv3client.Filter{Values: []string{brokerName}}
+			}
+			return client.ServicePlans.Single(ctx, opts)
+		},
+		get:  client.ServicePlans.Get,
+		guid: func(plan *cfv3resource.ServicePlan) string { return plan.GUID },
+	}
+}
+
+// Returns the GUID of the org with the given name, the lookup is shared by all resources of the provider via the lookup cache.
+func lookupOrgByName(ctx context.Context, client *cfv3client.Client, lookups *managers.LookupCache, name string) (string, error) {
+	guid, _, err := orgLookup(client, name).resolve(ctx, lookups)
+	return guid, err
+}
+
+// Returns the GUID of the space with the given name in the org, the lookup is shared by all resources of the provider via the lookup cache.
+func lookupSpaceByName(ctx context.Context, client *cfv3client.Client, lookups *managers.LookupCache, orgGUID string, name string) (string, error) {
+	guid, _, err := spaceLookup(client, orgGUID, name).resolve(ctx, lookups)
+	return guid, err
+}
+
 func mapMetadataValueToType(ctx context.Context, generic map[string]*string) (basetypes.MapValue, diag.Diagnostics) {
 
 	var out basetypes.MapValue
@@ -0,0 +1,23 @@
+action "cloudfoundry_app_restage" "backend" {
+  config {
+    app                          = cloudfoundry_app.backend.id
+    strategy                     = "rolling"
+    max_in_flight                = 2
+    app_deployed_running_timeout = 10
+  }
+}
+
+# Restages the app after the buildpack was updated, alternatively run
+# terraform apply -invoke action.cloudfoundry_app_restage.backend
+resource "cloudfoundry_buildpack" "nodejs" {
+  name     = "custom_nodejs_buildpack"
+  position = 1
+  path     = "nodejs_buildpack-cached-cflinuxfs4-v1.8.39.zip"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.cloudfoundry_app_restage.backend]
+    }
+  }
+}
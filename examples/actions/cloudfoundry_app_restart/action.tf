@@ -0,0 +1,21 @@
+action "cloudfoundry_app_restart" "backend" {
+  config {
+    app      = cloudfoundry_app.backend.id
+    strategy = "rolling"
+  }
+}
+
+# Restarts the app whenever the binding is replaced so that it picks up the new credentials
+resource "cloudfoundry_service_credential_binding" "db" {
+  type             = "app"
+  name             = "db-binding"
+  service_instance = "e9ec29ca-993d-42e2-9c3b-d5b1b1b2d2a8"
+  app              = cloudfoundry_app.backend.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.cloudfoundry_app_restart.backend]
+    }
+  }
+}
@@ -0,0 +1,18 @@
+# Scales the app for a load test with
+# terraform apply -invoke action.cloudfoundry_app_scale.load_test
+action "cloudfoundry_app_scale" "load_test" {
+  config {
+    app       = cloudfoundry_app.backend.id
+    instances = 5
+    memory    = "2G"
+    strategy  = "rolling"
+  }
+}
+
+action "cloudfoundry_app_scale" "worker" {
+  config {
+    app          = cloudfoundry_app.backend.id
+    process_type = "worker"
+    instances    = 0
+  }
+}
@@ -0,0 +1,11 @@
+data "cloudfoundry_app_package" "latest" {
+  app = "4f1e7b2c-8d3a-4c5e-9b6f-0a1d2e3f4a5b"
+}
+
+data "cloudfoundry_app_package" "by_id" {
+  id = "0d8e7f6a-5b4c-4d3e-a2f1-0e9d8c7b6a5f"
+}
+
+output "package_state" {
+  value = data.cloudfoundry_app_package.latest.state
+}
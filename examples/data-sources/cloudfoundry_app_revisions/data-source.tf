@@ -0,0 +1,12 @@
+data "cloudfoundry_app_revisions" "all" {
+  app = "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"
+}
+
+data "cloudfoundry_app_revisions" "deployed" {
+  app      = "b8a1c3d2-5f6e-4a7b-9c0d-1e2f3a4b5c6d"
+  deployed = true
+}
+
+output "revisions" {
+  value = [for r in data.cloudfoundry_app_revisions.all.revisions : "${r.version}: ${r.description}"]
+}
@@ -0,0 +1,5 @@
+data "cloudfoundry_feature_flags" "all" {}
+
+output "enabled_feature_flags" {
+  value = [for f in data.cloudfoundry_feature_flags.all.feature_flags : f.name if f.enabled]
+}
@@ -0,0 +1,10 @@
+ephemeral "cloudfoundry_access_token" "token" {}
+
+# The token is never stored in the plan or state, e.g. when used in the configuration of other providers.
+provider "restapi" {
+  uri                  = ephemeral.cloudfoundry_access_token.token.api_url
+  write_returns_object = true
+  headers = {
+    Authorization = "${ephemeral.cloudfoundry_access_token.token.token_type} ${ephemeral.cloudfoundry_access_token.token.access_token}"
+  }
+}
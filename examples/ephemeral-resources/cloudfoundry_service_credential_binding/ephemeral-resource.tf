@@ -0,0 +1,13 @@
+ephemeral "cloudfoundry_service_credential_binding" "key" {
+  type             = "key"
+  name             = "hifi"
+  service_instance = "e9ec29ca-993d-42e2-9c5b-cb17b1972cce"
+}
+
+# The credentials are never stored in the plan or state, e.g. when passed to write-only attributes.
+resource "vault_kv_secret_v2" "hifi" {
+  mount                = "kv"
+  name                 = "cloudfoundry/hifi"
+  data_json_wo         = jsonencode(jsondecode(ephemeral.cloudfoundry_service_credential_binding.key.credential_binding).credentials)
+  data_json_wo_version = 1
+}
@@ -0,0 +1,14 @@
+locals {
+  instances = 3
+  memory    = "1536M"
+}
+
+# Returns "4608M" for the total memory of all instances
+output "total_memory" {
+  value = provider::cloudfoundry::format_size(local.instances * provider::cloudfoundry::parse_size(local.memory))
+}
+
+# Returns "2G"
+output "org_quota_memory" {
+  value = provider::cloudfoundry::format_size(2048)
+}
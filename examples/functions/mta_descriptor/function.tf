@@ -0,0 +1,21 @@
+locals {
+  descriptor = provider::cloudfoundry::mta_descriptor("./my-mta_1.0.0.mtar")
+}
+
+# Deploys all application modules of the archive except the ones for testing
+resource "cloudfoundry_mta" "mta" {
+  space     = "02c0cc92-6ecc-44b1-b7b2-096ca19ee143"
+  mtar_path = "./my-mta_1.0.0.mtar"
+  namespace = local.descriptor.namespace
+  modules = [
+    for module in local.descriptor.modules : module.name
+    if module.type == "application" && !endswith(module.name, "-test")
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = startswith(local.descriptor.version, "1.")
+      error_message = "Only major version 1 of ${local.descriptor.id} is supported."
+    }
+  }
+}
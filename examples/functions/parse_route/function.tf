@@ -0,0 +1,16 @@
+variable "route_url" {
+  type    = string
+  default = "myapp.apps.example.com/api"
+}
+
+data "cloudfoundry_domain" "domain" {
+  name = "apps.example.com"
+}
+
+# Creates the route myapp.apps.example.com/api in the domain apps.example.com
+resource "cloudfoundry_route" "route" {
+  space  = "795e0b86-3bfc-4ba6-9b5c-7e6f7f9aa6a1"
+  domain = data.cloudfoundry_domain.domain.id
+  host   = provider::cloudfoundry::parse_route(var.route_url, data.cloudfoundry_domain.domain.name).host
+  path   = provider::cloudfoundry::parse_route(var.route_url, data.cloudfoundry_domain.domain.name).path
+}
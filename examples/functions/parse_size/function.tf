@@ -0,0 +1,14 @@
+variable "memory" {
+  type    = string
+  default = "2G"
+
+  validation {
+    condition     = provider::cloudfoundry::parse_size(var.memory) <= 4096
+    error_message = "Apps must not use more than 4G of memory."
+  }
+}
+
+# Returns 2048
+output "memory_in_mb" {
+  value = provider::cloudfoundry::parse_size(var.memory)
+}
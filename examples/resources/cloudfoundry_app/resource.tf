@@ -107,4 +107,68 @@ resource "cloudfoundry_app" "http-bin-sidecar" {
     }
   ]
   no_route = true
-}
\ No newline at end of file
+}
+
+resource "cloudfoundry_app" "canary-server" {
+  name                         = "tf-test-canary-nodejs"
+  space_name                   = "tf-space-1"
+  org_name                     = "PerformanceTeamBLR"
+  path                         = zipper_file.fixture.output_path
+  source_code_hash             = zipper_file.fixture.output_sha
+  instances                    = 4
+  strategy                     = "canary"
+  app_deployed_running_timeout = 10
+  canary_steps = [
+    {
+      instance_weight = 25
+      pause           = "5m"
+    },
+    {
+      instance_weight = 100
+    }
+  ]
+}
+
+resource "cloudfoundry_app" "rolling-server" {
+  name             = "tf-test-rolling-nodejs"
+  space_name       = "tf-space-1"
+  org_name         = "PerformanceTeamBLR"
+  path             = zipper_file.fixture.output_path
+  source_code_hash = zipper_file.fixture.output_sha
+  instances        = 6
+  strategy         = "rolling"
+  max_in_flight    = 3
+}
+
+output "deployment" {
+  value = "${cloudfoundry_app.rolling-server.deployment_status}/${cloudfoundry_app.rolling-server.deployment_reason} (revision ${cloudfoundry_app.rolling-server.current_revision_version})"
+}
+
+# Roll back to revision 3 of the app without pushing the bits again
+resource "cloudfoundry_app" "pinned-server" {
+  name             = "tf-test-pinned-nodejs"
+  space_name       = "tf-space-1"
+  org_name         = "PerformanceTeamBLR"
+  path             = zipper_file.fixture.output_path
+  source_code_hash = zipper_file.fixture.output_sha
+  instances        = 2
+  strategy         = "rolling"
+  revision         = "3"
+}
+
+# Stage the app with Cloud Native Buildpacks pulled from a private registry
+resource "cloudfoundry_app" "cnb-server" {
+  name             = "tf-test-cnb-nodejs"
+  space_name       = "tf-space-1"
+  org_name         = "PerformanceTeamBLR"
+  path             = zipper_file.fixture.output_path
+  source_code_hash = zipper_file.fixture.output_sha
+  lifecycle_type   = "cnb"
+  buildpacks       = ["docker://registry.example.com/paketo-buildpacks/nodejs"]
+  cnb_credentials = {
+    "registry.example.com" = {
+      username = "registry-user"
+      password = "registry-password"
+    }
+  }
+}
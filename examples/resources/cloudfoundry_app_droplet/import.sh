@@ -0,0 +1,19 @@
+# terraform import cloudfoundry_app_droplet.<resource_name> <droplet_guid>
+
+terraform import cloudfoundry_app_droplet.my_droplet 5b3f2c71-0d1e-4f6a-9b8c-7d6e5f4a3b2c
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_app_droplet.<resource_name>
+  id = "<droplet_guid>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+to = cloudfoundry_app_droplet.<resource_name>
+identity = {
+  droplet_guid = "<droplet_guid>"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,30 @@
+data "cloudfoundry_app" "dev" {
+  name       = "my-app"
+  space_name = "dev"
+  org_name   = "tf-org-1"
+}
+
+data "cloudfoundry_app" "prod" {
+  name       = "my-app"
+  space_name = "prod"
+  org_name   = "tf-org-1"
+}
+
+# Promote the current droplet of the app in the dev space to the app in the prod space
+resource "cloudfoundry_app_droplet" "promoted" {
+  app        = data.cloudfoundry_app.prod.id
+  source_app = data.cloudfoundry_app.dev.id
+  labels = {
+    "promoted-from" = "dev"
+  }
+}
+
+# Upload a droplet which was built and downloaded in CI
+resource "cloudfoundry_app_droplet" "uploaded" {
+  app              = data.cloudfoundry_app.prod.id
+  path             = "droplets/my-app.tgz"
+  source_code_hash = filesha256("droplets/my-app.tgz")
+  process_types = {
+    web = "node index.js"
+  }
+}
@@ -0,0 +1,10 @@
+# terraform import cloudfoundry_app_features.<resource_name> <app_guid>
+
+terraform import cloudfoundry_app_features.my_app 9c2d4e6f-1a3b-4c5d-8e7f-6a5b4c3d2e1f
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_app_features.<resource_name>
+  id = "<app_guid>"
+}
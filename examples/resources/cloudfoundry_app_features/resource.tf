@@ -0,0 +1,6 @@
+resource "cloudfoundry_app_features" "my_app" {
+  app                 = "9c2d4e6f-1a3b-4c5d-8e7f-6a5b4c3d2e1f"
+  ssh                 = false
+  revisions           = true
+  service_binding_k8s = true
+}
@@ -0,0 +1,16 @@
+data "cloudfoundry_app" "app" {
+  name       = "my-app"
+  space_name = "tf-space-1"
+  org_name   = "tf-org-1"
+}
+
+resource "cloudfoundry_app_task" "migrate" {
+  app        = data.cloudfoundry_app.app.id
+  name       = "migrate"
+  command    = "bin/rake db:migrate"
+  memory     = "512M"
+  disk_quota = "1G"
+  triggers = {
+    schema_version = "42"
+  }
+}
@@ -0,0 +1,10 @@
+# terraform import cloudfoundry_environment_variable_group.<resource_name> <running|staging>
+
+terraform import cloudfoundry_environment_variable_group.running running
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_environment_variable_group.<resource_name>
+  id = "<running|staging>"
+}
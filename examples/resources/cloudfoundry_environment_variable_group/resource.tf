@@ -0,0 +1,15 @@
+resource "cloudfoundry_environment_variable_group" "running" {
+  type = "running"
+  variables = {
+    HTTP_PROXY  = "http://proxy.example.com:8080"
+    HTTPS_PROXY = "http://proxy.example.com:8080"
+    NO_PROXY    = "localhost,.internal"
+  }
+}
+
+resource "cloudfoundry_environment_variable_group" "staging" {
+  type = "staging"
+  variables = {
+    HTTPS_PROXY = "http://proxy.example.com:8080"
+  }
+}
@@ -0,0 +1,19 @@
+# terraform import cloudfoundry_feature_flag.<resource_name> <feature_flag_name>
+
+terraform import cloudfoundry_feature_flag.diego_docker diego_docker
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_feature_flag.<resource_name>
+  id = "<feature_flag_name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+to = cloudfoundry_feature_flag.<resource_name>
+identity = {
+  name = "<feature_flag_name>"
+  }
+}
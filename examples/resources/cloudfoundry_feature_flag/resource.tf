@@ -0,0 +1,10 @@
+resource "cloudfoundry_feature_flag" "diego_docker" {
+  name    = "diego_docker"
+  enabled = true
+}
+
+resource "cloudfoundry_feature_flag" "user_org_creation" {
+  name                 = "user_org_creation"
+  enabled              = false
+  custom_error_message = "Please request an org through the self-service portal"
+}
@@ -0,0 +1,10 @@
+# terraform import cloudfoundry_org_default_isolation_segment.<resource_name> <org_guid>
+
+terraform import cloudfoundry_org_default_isolation_segment.default 784b4cd0-4771-4e4d-9052-a07e178bae56
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_org_default_isolation_segment.<resource_name>
+  id = "<org_guid>"
+}
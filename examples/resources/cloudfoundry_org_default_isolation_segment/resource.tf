@@ -0,0 +1,13 @@
+resource "cloudfoundry_isolation_segment_entitlement" "entitlement" {
+  segment = "63ae51b9-9073-4409-81b0-3704b8de85dd"
+  orgs    = ["784b4cd0-4771-4e4d-9052-a07e178bae56"]
+}
+
+resource "cloudfoundry_org_default_isolation_segment" "default" {
+  org     = "784b4cd0-4771-4e4d-9052-a07e178bae56"
+  segment = cloudfoundry_isolation_segment_entitlement.entitlement.segment
+}
+
+output "apps_to_restart" {
+  value = cloudfoundry_org_default_isolation_segment.default.affected_apps
+}
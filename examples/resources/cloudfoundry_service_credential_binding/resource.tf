@@ -9,4 +9,22 @@ resource "cloudfoundry_service_credential_binding" "scb1" {
   type             = "key"
   name             = "hifi"
   service_instance = "e9ec29ca-993d-42e2-9c5b-cb17b1972cce"
-}
\ No newline at end of file
+}
+# Rotates the app binding whenever the trigger changes. The app is restarted with a rolling
+# deployment before the old binding is deleted.
+resource "cloudfoundry_service_credential_binding" "scb_rotated" {
+  type             = "app"
+  service_instance = "e9ec29ca-993d-42e2-9c5b-cb17b1972cce"
+  app              = "ec6ac2b3-fb79-43c4-9734-000d4299bd59"
+  rotation_trigger = "2026-10"
+  rotation_rollout = "restart"
+}
+
+# Rotates the service key whenever the trigger changes. The key is created as "hifi-rotated-<suffix>"
+# and the old key is deleted once the new one has been created.
+resource "cloudfoundry_service_credential_binding" "scb_key_rotated" {
+  type             = "key"
+  name             = "hifi-rotated"
+  service_instance = "e9ec29ca-993d-42e2-9c5b-cb17b1972cce"
+  rotation_trigger = "2026-10"
+}
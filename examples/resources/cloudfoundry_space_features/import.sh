@@ -0,0 +1,10 @@
+# terraform import cloudfoundry_space_features.<resource_name> <space_guid>
+
+terraform import cloudfoundry_space_features.my_space 02c0cc92-6ecc-44b1-b7b2-096ca19ee143
+
+#terraform import using id attribute in import block
+
+import {
+  to = cloudfoundry_space_features.<resource_name>
+  id = "<space_guid>"
+}
@@ -0,0 +1,4 @@
+resource "cloudfoundry_space_features" "my_space" {
+  space = "02c0cc92-6ecc-44b1-b7b2-096ca19ee143"
+  ssh   = false
+}
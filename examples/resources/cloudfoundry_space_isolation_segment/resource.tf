@@ -0,0 +1,23 @@
+resource "cloudfoundry_space_isolation_segment" "my_space" {
+  space   = "02c0cc92-6ecc-44b1-b7b2-096ca19ee143"
+  segment = "63ae51b9-9073-4409-81b0-3704b8de85dd"
+}
+
+# A space managed with Terraform leaves the isolation segment to cloudfoundry_space_isolation_segment
+resource "cloudfoundry_space" "team" {
+  name = "team"
+  org  = "ca721b24-e24d-4171-83e1-1ef6bd836b38"
+
+  lifecycle {
+    ignore_changes = [isolation_segment]
+  }
+}
+
+resource "cloudfoundry_space_isolation_segment" "team" {
+  space   = cloudfoundry_space.team.id
+  segment = "63ae51b9-9073-4409-81b0-3704b8de85dd"
+}
+
+output "apps_to_restart" {
+  value = cloudfoundry_space_isolation_segment.my_space.affected_apps
+}
@@ -6,12 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 	"strings"
-	"syscall"
 	"time"
 
+	"github.com/cloudfoundry/terraform-provider-cloudfoundry/internal/network"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,10 +29,13 @@ const (
 var pollSleepInterval = 2 * time.Second
 
 type MtaDescriptor struct {
-	SchemaVersion string `yaml:"_schema-version,omitempty"`
-	ID            string `yaml:"ID,omitempty"`
-	Version       string `yaml:"version,omitempty"`
-	Namespace     string `yaml:"namespace,omitempty"`
+	SchemaVersion string           `yaml:"_schema-version,omitempty"`
+	ID            string           `yaml:"ID,omitempty"`
+	Version       string           `yaml:"version,omitempty"`
+	Namespace     string           `yaml:"namespace,omitempty"`
+	Modules       []map[string]any `yaml:"modules,omitempty"`
+	Resources     []map[string]any `yaml:"resources,omitempty"`
+	Parameters    map[string]any   `yaml:"parameters,omitempty"`
 }
 
 // ref - https://github.com/cloudfoundry/multiapps-cli-plugin/blob/v3.2.2/util/archive_handler.go
@@ -86,7 +88,7 @@ func readZipFile(file *zip.File) ([]byte, error) {
 
 // ref - https://github.com/cloudfoundry/multiapps-cli-plugin/blob/v3.2.2/commands/deploy_command.go
 // CheckOngoingOperation checks for ongoing operation for mta with the specified id and tries to abort it.
-func CheckOngoingOperation(ctx context.Context, client *APIClient, mtaId string, namespace string, spaceGuid string) (bool, error) {
+func CheckOngoingOperation(ctx context.Context, client *APIClient, mtaId string, namespace string, spaceGuid string, timeout time.Duration) (bool, error) {
 	// Check if there is an ongoing operation for this MTA ID
 	ongoingOperation, err := findOngoingOperation(ctx, mtaId, namespace, client, spaceGuid)
 	if err != nil {
@@ -98,7 +100,7 @@ func CheckOngoingOperation(ctx context.Context, client *APIClient, mtaId string,
 		if err != nil {
 			return false, err
 		} else {
-			_, err = PollMtaOperation(ctx, client, spaceGuid, operationId, AbortedState)
+			_, err = PollMtaOperation(ctx, client, spaceGuid, operationId, AbortedState, timeout)
 			if err != nil {
 				return false, err
 			}
@@ -135,19 +137,23 @@ func isConflicting(operation Operation, mtaID string, namespace string, spaceGui
 		operation.AcquiredLock
 }
 
-// Keeps polling the MTA operation by its ID for completion.
-func PollMtaOperation(ctx context.Context, client *APIClient, spaceGuid string, operationId string, targetState string) (string, error) {
+// Keeps polling the MTA operation by its ID for completion until the timeout is reached.
+func PollMtaOperation(ctx context.Context, client *APIClient, spaceGuid string, operationId string, targetState string, timeout time.Duration) (string, error) {
 
 	var (
 		operationResponse        Operation
 		err                      error
 		consecutiveTransientErrs int
 	)
+	deadline := time.Now().Add(timeout)
 	for operationState := "RUNNING"; operationState != targetState; {
+		if time.Now().After(deadline) {
+			return messagesToString(operationResponse.Messages), fmt.Errorf("timed out after %s waiting for operation %s to reach state %s", timeout, operationId, targetState)
+		}
 		time.Sleep(pollSleepInterval)
 		operationResponse, _, err = client.DefaultApi.GetMtaOperation(ctx, spaceGuid, operationId, "messages")
 		if err != nil {
-			if isTransientNetworkError(err) && consecutiveTransientErrs < maxConsecutiveTransientErrors {
+			if network.IsTransientError(err) && consecutiveTransientErrs < maxConsecutiveTransientErrors {
 				consecutiveTransientErrs++
 				continue
 			}
@@ -165,18 +171,6 @@ func PollMtaOperation(ctx context.Context, client *APIClient, spaceGuid string,
 	return messagesToString(operationResponse.Messages), nil
 }
 
-// isTransientNetworkError returns true for errors that are likely transient and
-// safe to retry: connection reset by peer, unexpected EOF, and read/write timeouts.
-func isTransientNetworkError(err error) bool {
-	var netErr net.Error
-	if errors.As(err, &netErr) && netErr.Timeout() {
-		return true
-	}
-	return errors.Is(err, syscall.ECONNRESET) ||
-		errors.Is(err, io.EOF) ||
-		errors.Is(err, io.ErrUnexpectedEOF)
-}
-
 // ref - https://github.com/cloudfoundry/go-cfclient/blob/main/internal/http/response.go
 // returns the operationID if specified in the Location response header.
 func decodeOperationJobID(resp *http.Response) (string, error) {
@@ -205,9 +199,13 @@ func decodeOperationJobID(resp *http.Response) (string, error) {
 	return operationId, err
 }
 
-// Keeps polling the MTA job by its ID for completion.
-func PollMtaJob(ctx context.Context, client *APIClient, spaceGuid string, jobId string, targetState string, xInstance string, namespace string) (jobResponse UploadStatus, err error) {
+// Keeps polling the MTA job by its ID for completion until the timeout is reached.
+func PollMtaJob(ctx context.Context, client *APIClient, spaceGuid string, jobId string, targetState string, xInstance string, namespace string, timeout time.Duration) (jobResponse UploadStatus, err error) {
+	deadline := time.Now().Add(timeout)
 	for jobState := "RUNNING"; jobState != targetState; {
+		if time.Now().After(deadline) {
+			return jobResponse, fmt.Errorf("timed out after %s waiting for upload job %s to reach state %s", timeout, jobId, targetState)
+		}
 		time.Sleep(pollSleepInterval)
 		jobResponse, _, err = client.DefaultApi.GetAsyncUploadJob(ctx, spaceGuid, jobId, xInstance, namespace)
 		if err != nil {
@@ -0,0 +1,45 @@
+package mta
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollMtaOperation(t *testing.T) {
+	interval := pollSleepInterval
+	pollSleepInterval = 10 * time.Millisecond
+	defer func() { pollSleepInterval = interval }()
+
+	var polls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		state := "RUNNING"
+		if r.URL.Path == "/api/v1/spaces/space/operations/finished" && polls > 2 {
+			state = "FINISHED"
+		}
+		_, _ = io.WriteString(w, `{"processId":"operation","state":"`+state+`","messages":[{"text":"Deploying"}]}`)
+	}))
+	defer server.Close()
+	client := NewAPIClient(NewConfiguration(server.URL, "terraform", server.Client()))
+
+	t.Run("happy path - operation reaches the target state", func(t *testing.T) {
+		polls = 0
+		_, err := PollMtaOperation(t.Context(), client, "space", "finished", "FINISHED", time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, polls)
+	})
+	t.Run("error path - operation does not finish within the timeout", func(t *testing.T) {
+		polls = 0
+		start := time.Now()
+		messages, err := PollMtaOperation(t.Context(), client, "space", "running", "FINISHED", 50*time.Millisecond)
+		assert.ErrorContains(t, err, "timed out after 50ms waiting for operation running to reach state FINISHED")
+		assert.Contains(t, messages, "Deploying")
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
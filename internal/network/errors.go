@@ -0,0 +1,21 @@
+package network
+
+import (
+	"errors"
+	"io"
+	"net"
+	"syscall"
+)
+
+// IsTransientError returns true for network errors which are likely transient and safe to retry, like a reset or
+// refused connection, an unexpected EOF or a read/write timeout.
+func IsTransientError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
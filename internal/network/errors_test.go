@@ -0,0 +1,50 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"syscall"
+	"testing"
+)
+
+func TestIsTransientError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err       error
+		transient bool
+	}{
+		"connection reset": {
+			err:       &url.Error{Op: "Get", URL: "https://api.example.com", Err: syscall.ECONNRESET},
+			transient: true,
+		},
+		"connection refused": {
+			err:       fmt.Errorf("dial: %w", syscall.ECONNREFUSED),
+			transient: true,
+		},
+		"unexpected eof": {
+			err:       io.ErrUnexpectedEOF,
+			transient: true,
+		},
+		"timeout": {
+			err:       &url.Error{Op: "Get", URL: "https://api.example.com", Err: context.DeadlineExceeded},
+			transient: true,
+		},
+		"other error": {
+			err:       errors.New("certificate signed by unknown authority"),
+			transient: false,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsTransientError(test.err); got != test.transient {
+				t.Errorf("IsTransientError(%v) = %t, want %t", test.err, got, test.transient)
+			}
+		})
+	}
+}